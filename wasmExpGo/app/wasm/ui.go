@@ -24,17 +24,21 @@ import (
 	"fmt"
 
 	"hestiaGo/hestiaKernel/hestiaChainKernel"
-	"hestiaGo/hestiaOS"
 	"hestiaGo/hestiaOS/hestiaWASM"
 	"hestiaGo/hestiaUI"
 	"hestiaGo/hestiaUI/hestiaCoreUI"
 )
 
 type ui struct {
-	event    *hestiaWASM.Event
 	kernel   *hestiaChainKernel.Kernel
 	button   *hestiaWASM.Object
 	listener *hestiaWASM.EventListener
+	abort    *hestiaWASM.AbortController
+}
+
+type uiRun struct {
+	*ui
+	event *hestiaWASM.Event
 }
 
 func uiInit() {
@@ -43,21 +47,23 @@ func uiInit() {
 	// create the UI controller
 	controller = &ui{
 		kernel: &hestiaChainKernel.Kernel{},
-		listener: &hestiaWASM.EventListener{
-			Name: "click",
-			Function: func(e *hestiaWASM.Event) {
-				controller.event = e
-				_ = hestiaChainKernel.Trigger(controller.kernel)
-			},
-			PreventDefault: true,
-		},
+		abort:  &hestiaWASM.AbortController{},
+	}
+
+	controller.listener = &hestiaWASM.EventListener{
+		Name:           "click",
+		PreventDefault: true,
+		Signal:         controller.abort,
 	}
 
 	// render base UI for first interaction
 	controller.button, _ = hestiaWASM.CreateElement("button")
-	html := []byte("Render WASM Contents")
-	_ = hestiaWASM.SetHTML(controller.button, &html)
-	_ = hestiaWASM.AddEventListener(controller.button, controller.listener)
+	_ = hestiaWASM.SetAttribute(controller.button, "type", "button")
+	_ = hestiaWASM.SetText(controller.button, "Render WASM Contents")
+	_ = hestiaWASM.AddChainEventListener(controller.button,
+		controller.listener,
+		controller.kernel,
+	)
 	_ = hestiaWASM.Append(hestiaWASM.Body(), controller.button)
 
 	// generate and debug CSS
@@ -72,21 +78,23 @@ func uiInit() {
 
 	// start chain server
 	hestiaChainKernel.Start(controller.kernel, func(arg any) (out any) {
-		signal, ok := arg.(uint16)
-		if !ok {
-			return nil
-		}
+		switch payload := arg.(type) {
+		case *hestiaChainKernel.Payload:
+			e, ok := payload.Data.(*hestiaWASM.Event)
+			if !ok {
+				return nil
+			}
 
-		switch signal {
-		case hestiaOS.SIGNAL_SIGCONT:
 			hestiaChainKernel.SetNext(controller.kernel, _sourceUIChanges)
-		case hestiaOS.SIGNAL_SIGSTOP,
-			hestiaOS.SIGNAL_SIGINT,
-			hestiaOS.SIGNAL_SIGTERM,
-			hestiaOS.SIGNAL_SIGKILL:
+
+			return &uiRun{
+				ui:    controller,
+				event: e,
+			}
 		}
 
-		return controller
+		// other signals (e.g. hestiaOS.SIGNAL_SIGSTOP) need no chain run
+		return nil
 	}, 5)
 }
 
@@ -110,8 +118,7 @@ func _renderUIChanges(arg any) any {
 	// execute function
 	body := hestiaWASM.Body()
 	tag, _ := hestiaWASM.CreateElement("h2")
-	html := []byte("button content rendered here!")
-	_ = hestiaWASM.SetHTML(tag, &html)
+	_ = hestiaWASM.SetText(tag, "button content rendered here!")
 	_ = hestiaWASM.Append(body, tag)
 
 	// chain next event
@@ -125,17 +132,8 @@ func _removeUIFunction(arg any) any {
 	controller := __convertArgument(arg)
 
 	// execute function
-	fmt.Printf("removing the listener 1st-time\n")
-	_ = hestiaWASM.RemoveEventListener(controller.button, controller.listener)
-
-	fmt.Printf("removing the listener 2nd-time\n")
-	_ = hestiaWASM.RemoveEventListener(controller.button, controller.listener)
-
-	fmt.Printf("removing the listener 3rd-time\n")
-	_ = hestiaWASM.RemoveEventListener(controller.button, controller.listener)
-
-	fmt.Printf("removing the listener 4th-time\n")
-	_ = hestiaWASM.RemoveEventListener(controller.button, controller.listener)
+	fmt.Printf("aborting all UI listeners\n")
+	_ = hestiaWASM.Abort(controller.abort)
 
 	// chain next event
 	// DONE - no more chaining since UI is dead. Stopping controller as
@@ -146,10 +144,10 @@ func _removeUIFunction(arg any) any {
 	return controller
 }
 
-func __convertArgument(arg any) (controller *ui) {
+func __convertArgument(arg any) (controller *uiRun) {
 	var ok bool
 
-	if controller, ok = arg.(*ui); !ok {
+	if controller, ok = arg.(*uiRun); !ok {
 		return nil
 	}
 
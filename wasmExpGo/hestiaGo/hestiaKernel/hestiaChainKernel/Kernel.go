@@ -26,13 +26,29 @@ import (
 	"sync"
 )
 
+const (
+	// SIGNAL_PAYLOAD is the signal sent by `TriggerPayload(...)`.
+	//
+	// It is placed away from the operating system's signal values.
+	SIGNAL_PAYLOAD = uint16(0x0100)
+)
+
 // Kernel is the Chain data structure.
 type Kernel struct {
 	signaler *hestiaOS.Signal
 	next     func(any) any
+	payloads []any
 	mutex    *sync.Mutex
 }
 
+// Payload is the first function block's input for `TriggerPayload(...)`.
+//
+// Each `TriggerPayload(...)` call delivers exactly one Payload with its own
+// Data in the same order the calls were made.
+type Payload struct {
+	Data any
+}
+
 // HasNext checks a given kernel having a next function block to execute.
 //
 // This function was designed to be operating the kernel in a thread-safe
@@ -147,7 +163,7 @@ func SetNext(kernel *Kernel, fx func(any) any) (err hestiaError.Error) {
 //   1. hestiaError.OK | `0` - signal sent successfully.
 //   2. hestiaError.EOWNERDEAD | `130` - given `kernel` is unsable. Use
 //                                       `Validate(...)` function to diagnose.
-//   3. hestiaError.EHOSTUNREACH | `61` - the chain signaler is idling.
+//   3. hestiaError.EHOSTUNREACH | `113` - the chain signaler is idling.
 func Signal(kernel *Kernel, signal uint16) (err hestiaError.Error) {
 	err = Validate(kernel)
 	if err != hestiaError.OK {
@@ -178,19 +194,26 @@ func Signal(kernel *Kernel, signal uint16) (err hestiaError.Error) {
 //     2. `hestiaOS.SIGNAL_SIGINT`
 //     3. `hestiaOS.SIGNAL_SIGTERM`
 //     4. `hestiaOS.SIGNAL_SIGKILL`
-// If the kernel is operating in an OS environment, the OS signals can influence
-// the kernel directly. Should any of these signals are given to the first
-// function block, it is required to perform graceful shutdown in this ONE (1)
-// block itself as **the next block SHALL NOT be executed at all**.
+// These signals only come from `Signal(...)` and `Stop(...)` since the kernel
+// ignores the operating system signals (see `hestiaOS.SignalIgnoreOS(...)`)
+// which belong to the app lifecycle (e.g. hestiaAppKernel). Should any of
+// these signals are given to the first function block, it is required to
+// perform graceful shutdown in this ONE (1) block itself as **the next block
+// SHALL NOT be executed at all**.
 //
 // This kernel listen to these special signals and other unidentified signal for
 // triggering a chain event:
 //     1. `hestiaOS.SIGNAL_SIGCONT`
+//     2. `SIGNAL_PAYLOAD`
 //
-// This function accepts the first function block (`first`) that only accepts
+// This function accepts the first function block (`first`) that accepts
 // hestiaOS.Signal (`uint16`) stated above value as its input parameter. The
-// goal of the first block is to distinguish next block based on the triggered
-// action and also formulating the required return value for the chain.
+// only exception is `SIGNAL_PAYLOAD` from `TriggerPayload(...)` where the
+// first block receives `*Payload` instead. Upon any signal, all the queued
+// Payloads are executed one by one in their queued order before the signal
+// itself (which is not executed again for `SIGNAL_PAYLOAD`). The goal of the first block is to
+// distinguish next block based on the triggered action and also formulating
+// the required return value for the chain.
 //
 // It accepts the following parameters:
 //   1. `kernel` - the Kernel object.
@@ -205,9 +228,8 @@ func Signal(kernel *Kernel, signal uint16) (err hestiaError.Error) {
 //   4. hestiaError.ESTRPIPE | `86` - failed to initialize signaler.
 //   5. hestiaError.EBUSY | `16` - the kernel is already running.
 func Start(kernel *Kernel, first func(any) any, buffer int) (err hestiaError.Error) {
-	var ret any
+	var payload *Payload
 	var signal uint16
-	var function func(any) any
 
 	err = Validate(kernel)
 	if err != hestiaError.OK {
@@ -230,7 +252,11 @@ func Start(kernel *Kernel, first func(any) any, buffer int) (err hestiaError.Err
 
 	kernel.mutex.Lock()
 	kernel.signaler = &hestiaOS.Signal{}
+	kernel.payloads = nil
 	err = hestiaOS.SignalInit(kernel.signaler, buffer)
+	if err == hestiaError.OK {
+		err = hestiaOS.SignalIgnoreOS(kernel.signaler, true)
+	}
 	kernel.mutex.Unlock()
 
 	if err != hestiaError.OK {
@@ -239,16 +265,18 @@ func Start(kernel *Kernel, first func(any) any, buffer int) (err hestiaError.Err
 
 listen:
 	signal = hestiaOS.SignalWait(_getSignaler(kernel))
-	ret = signal
-	function = nil
 
-	// execute chain
-	SetNext(kernel, first)
-	for HasNext(kernel) == hestiaError.OK {
-		function = _getNext(kernel)
-		ret = function(ret)
+	// deliver all queued payloads since their signals can be skipped
+	for payload = _popPayload(kernel); payload != nil; payload = _popPayload(kernel) {
+		_runChain(kernel, first, payload)
+	}
+
+	if signal == SIGNAL_PAYLOAD {
+		goto listen
 	}
-	ret = nil
+
+	// execute chain
+	_runChain(kernel, first, signal)
 
 	// decide next action based on signal
 	switch signal {
@@ -286,6 +314,54 @@ func Trigger(kernel *Kernel) (err hestiaError.Error) {
 	return Signal(kernel, hestiaOS.SIGNAL_SIGCONT)
 }
 
+// TriggerPayload sends a data-carrying event into a running Kernel object.
+//
+// Unlike `Trigger(...)`, the first function block receives the given `data`
+// wrapped in `*Payload` for this particular run. Hence, consecutive triggers
+// never overwrite each other's data like sharing a common variable does.
+//
+// The `data` is queued synchronously in the calling order before the signal
+// is sent without waiting. Hence, it never blocks the caller (e.g. a
+// Javascript callback). When the signal buffer is full, the signal is skipped
+// since the kernel executes all queued Payloads upon its next signal anyway.
+//
+// This function was designed to be operating the kernel in a thread-safe
+// manner.
+//
+// It accepts the following parameters:
+//   1. `kernel` - the Kernel object.
+//   2. `data` - the data for the first function block.
+//
+// It returns the following outputs:
+//   1. hestiaError.OK | `0` - payload queued successfully.
+//   2. hestiaError.EOWNERDEAD | `130` - given `kernel` is unsable. Use
+//                                       `Validate(...)` function to diagnose.
+//   3. hestiaError.EHOSTUNREACH | `113` - the chain signaler is idling.
+func TriggerPayload(kernel *Kernel, data any) (err hestiaError.Error) {
+	var signaler *hestiaOS.Signal
+
+	err = Validate(kernel)
+	if err != hestiaError.OK {
+		return hestiaError.EOWNERDEAD
+	}
+
+	kernel.mutex.Lock()
+	signaler = kernel.signaler
+	if signaler != nil {
+		kernel.payloads = append(kernel.payloads, data)
+	}
+	kernel.mutex.Unlock()
+
+	if signaler == nil {
+		return hestiaError.EHOSTUNREACH
+	}
+
+	// a full buffer already has a pending signal to deliver the payload
+	_ = hestiaOS.SignalTrySend(signaler, SIGNAL_PAYLOAD)
+
+	return hestiaError.OK
+}
+
 // Validate checks a given kernel is ready for operation.
 //
 // This function was designed to be operating the kernel in a thread-safe
@@ -328,3 +404,29 @@ func _getSignaler(kernel *Kernel) (out *hestiaOS.Signal) {
 
 	return out
 }
+
+func _popPayload(kernel *Kernel) (out *Payload) {
+	kernel.mutex.Lock()
+	if len(kernel.payloads) > 0 {
+		out = &Payload{
+			Data: kernel.payloads[0],
+		}
+		kernel.payloads[0] = nil
+		kernel.payloads = kernel.payloads[1:]
+	}
+	kernel.mutex.Unlock()
+
+	return out
+}
+
+func _runChain(kernel *Kernel, first func(any) any, input any) {
+	var ret any
+	var function func(any) any
+
+	ret = input
+	SetNext(kernel, first)
+	for HasNext(kernel) == hestiaError.OK {
+		function = _getNext(kernel)
+		ret = function(ret)
+	}
+}
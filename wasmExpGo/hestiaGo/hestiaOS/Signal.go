@@ -22,6 +22,7 @@ package hestiaOS
 
 import (
 	"hestiaGo/hestiaError"
+	"sync"
 )
 
 type SignalOSChannel adapterSignalOSChannel
@@ -65,6 +66,62 @@ const (
 	SIGNAL_SIGXFSZ   = uint16(0x19)
 )
 
+// SignalBrowserEvent is the browser page lifecycle event for wasm builds.
+//
+// In wasm builds, these browser events are treated as the operating system
+// signals (see `SignalMapBrowser(...)`).
+type SignalBrowserEvent uint8
+
+// SignalBrowserEvent representations ID
+const (
+	// SIGNAL_BROWSER_HIDDEN is `visibilitychange` to hidden state.
+	SIGNAL_BROWSER_HIDDEN SignalBrowserEvent = iota
+
+	// SIGNAL_BROWSER_VISIBLE is `visibilitychange` to visible state.
+	SIGNAL_BROWSER_VISIBLE
+
+	// SIGNAL_BROWSER_PAGEHIDE is `pagehide` (e.g. navigating away).
+	SIGNAL_BROWSER_PAGEHIDE
+
+	// SIGNAL_BROWSER_PAGESHOW is `pageshow` restored from back-forward
+	// cache. The initial page load is not signaled.
+	SIGNAL_BROWSER_PAGESHOW
+
+	// SIGNAL_BROWSER_FREEZE is `freeze` (page frozen by the browser).
+	SIGNAL_BROWSER_FREEZE
+
+	// SIGNAL_BROWSER_RESUME is `resume` (frozen page resumed).
+	SIGNAL_BROWSER_RESUME
+
+	// SIGNAL_BROWSER_BEFOREUNLOAD is `beforeunload` (page is closing).
+	SIGNAL_BROWSER_BEFOREUNLOAD
+
+	// SIGNAL_BROWSER_ONLINE is `online` (network is back).
+	SIGNAL_BROWSER_ONLINE
+
+	// SIGNAL_BROWSER_OFFLINE is `offline` (network is lost).
+	SIGNAL_BROWSER_OFFLINE
+
+	signal_BROWSER_MAX
+)
+
+var signalBrowserMap = struct {
+	list  [signal_BROWSER_MAX]uint16
+	mutex sync.Mutex
+}{
+	list: [signal_BROWSER_MAX]uint16{
+		SIGNAL_BROWSER_HIDDEN:       SIGNAL_SIGSTOP,
+		SIGNAL_BROWSER_VISIBLE:      SIGNAL_SIGCONT,
+		SIGNAL_BROWSER_PAGEHIDE:     SIGNAL_SIGSTOP,
+		SIGNAL_BROWSER_PAGESHOW:     SIGNAL_SIGCONT,
+		SIGNAL_BROWSER_FREEZE:       SIGNAL_SIGSTOP,
+		SIGNAL_BROWSER_RESUME:       SIGNAL_SIGCONT,
+		SIGNAL_BROWSER_BEFOREUNLOAD: SIGNAL_SIGTERM,
+		SIGNAL_BROWSER_ONLINE:       SIGNAL_SIGCONT,
+		SIGNAL_BROWSER_OFFLINE:      SIGNAL_SIGSTOP,
+	},
+}
+
 // Signal is for event-driven trigger independent on platform OS.
 //
 // Unlike syscall.Signal, hestiaOS's Signal permits the use of its own feature
@@ -77,7 +134,9 @@ const (
 //
 // To ensure there is enough signal ID, a 16-bit unsigned integer is used.
 type Signal struct {
-	channel chan uint16
+	channel   chan uint16
+	osChannel SignalOSChannel
+	ignoreOS  bool
 }
 
 // SignalInit initializes the hestiaOS.Signal object.
@@ -103,6 +162,26 @@ func SignalWait(sig *Signal) uint16 {
 	return _signalWait(sig)
 }
 
+// SignalIgnoreOS sets the hestiaOS.Signal object to ignore the operating
+// system signals.
+//
+// Once set, `SignalWait(...)` only returns the signals from `SignalSend(...)`.
+// This is meant for event-driven users (e.g. hestiaChainKernel) that must not
+// be paused or stopped by the platform like the app lifecycle does.
+//
+// It shall returns:
+//   1. `hestiaError.OK` | `0` = Successful
+//   2. `hestiaError.ENOENT` | `2` = given parameter is `nil`.
+func SignalIgnoreOS(sig *Signal, ignore bool) hestiaError.Error {
+	if sig == nil {
+		return hestiaError.ENOENT
+	}
+
+	sig.ignoreOS = ignore
+
+	return hestiaError.OK
+}
+
 // SignalSend sends a given signal into the hestiaOS.Signal object.
 //
 // It shall returns:
@@ -118,7 +197,72 @@ func SignalSubscribeOS(ch SignalOSChannel) {
 	_signalSubscribeOS(ch)
 }
 
+// SignalTrySend sends a given signal into the hestiaOS.Signal object without
+// blocking.
+//
+// Unlike `SignalSend(...)`, the signal is not sent when the hestiaOS.Signal's
+// buffer is full. This is useful for notifications where a pending signal
+// already implies the latest state (e.g. "new data is queued").
+//
+// It shall returns:
+//   1. `hestiaError.OK` | `0` = Successful
+//   2. `hestiaError.ENOENT` | `2` = given parameter is `nil`.
+//   3. `hestiaError.EAGAIN` | `11` = the buffer is full.
+func SignalTrySend(sig *Signal, data uint16) hestiaError.Error {
+	return _signalTrySend(sig, data)
+}
+
 // SignalUnsubscribeOS set a channel to stop receive signal from the OS.
 func SignalUnsubscribeOS(ch SignalOSChannel) {
 	_signalUnsubscribeOS(ch)
 }
+
+// SignalMapBrowser sets the signal sent for a browser page lifecycle event.
+//
+// This only takes effect in wasm builds where the browser events are the
+// operating system signals received by `SignalWait(...)`. The default
+// mapping is:
+//   1. SIGNAL_BROWSER_HIDDEN, SIGNAL_BROWSER_PAGEHIDE,
+//      SIGNAL_BROWSER_FREEZE, SIGNAL_BROWSER_OFFLINE - `SIGNAL_SIGSTOP`.
+//   2. SIGNAL_BROWSER_VISIBLE, SIGNAL_BROWSER_PAGESHOW,
+//      SIGNAL_BROWSER_RESUME, SIGNAL_BROWSER_ONLINE - `SIGNAL_SIGCONT`.
+//   3. SIGNAL_BROWSER_BEFOREUNLOAD - `SIGNAL_SIGTERM`.
+//
+// Since a page transition usually fires a few of these events together
+// (e.g. `visibilitychange` then `pagehide`), consecutive repeating signals
+// are only sent once.
+//
+// Keep in mind that every Signal waiting for the operating system signals
+// receives them unless it was set by `SignalIgnoreOS(...)` (e.g.
+// hestiaChainKernel). Map the events to your own custom codes (away from the
+// operating system's values) if the default is not desired.
+//
+// **IMPORTANT NOTE**: the browser does not wait for Go on
+// SIGNAL_BROWSER_BEFOREUNLOAD. Keep the stopping functions short.
+//
+// It accepts:
+//   1. `event` - the browser event.
+//   2. `signal` - the signal to send. `0` disables the event.
+//
+// It shall returns:
+//   1. `hestiaError.OK` | `0` = Successful
+//   2. `hestiaError.EINVAL` = given `event` is unknown.
+func SignalMapBrowser(event SignalBrowserEvent, signal uint16) hestiaError.Error {
+	if event >= signal_BROWSER_MAX {
+		return hestiaError.EINVAL
+	}
+
+	signalBrowserMap.mutex.Lock()
+	signalBrowserMap.list[event] = signal
+	signalBrowserMap.mutex.Unlock()
+
+	return hestiaError.OK
+}
+
+func _getBrowserSignal(event SignalBrowserEvent) (signal uint16) {
+	signalBrowserMap.mutex.Lock()
+	signal = signalBrowserMap.list[event]
+	signalBrowserMap.mutex.Unlock()
+
+	return signal
+}
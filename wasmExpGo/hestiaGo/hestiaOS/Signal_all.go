@@ -56,6 +56,19 @@ func _signalSend(sig *Signal, data uint16) hestiaError.Error {
 	return hestiaError.OK
 }
 
+func _signalTrySend(sig *Signal, data uint16) hestiaError.Error {
+	if sig == nil {
+		return hestiaError.ENOENT
+	}
+
+	select {
+	case sig.channel <- data:
+		return hestiaError.OK
+	default:
+		return hestiaError.EAGAIN
+	}
+}
+
 func _signalWait(sig *Signal) (value uint16) {
 	var ok bool
 	var osSig os.Signal
@@ -66,6 +79,11 @@ func _signalWait(sig *Signal) (value uint16) {
 		goto done
 	}
 
+	if sig.ignoreOS {
+		value = <-sig.channel
+		goto done
+	}
+
 	// make a temporary OS-signal channel for its notices
 	chOS = make(chan os.Signal, 1)
 	SignalSubscribeOS(chOS)
@@ -25,10 +25,46 @@ package hestiaOS
 
 import (
 	"hestiaGo/hestiaError"
+	"sync"
+	"syscall/js"
+)
+
+const (
+	id_JS_ADD_EVENT_LISTENER = "addEventListener"
+	id_JS_DOCUMENT           = "document"
+	id_JS_HIDDEN             = "hidden"
+	id_JS_PERSISTED          = "persisted"
+	id_JS_VISIBILITY_STATE   = "visibilityState"
+)
+
+const (
+	event_BEFOREUNLOAD     = "beforeunload"
+	event_FREEZE           = "freeze"
+	event_OFFLINE          = "offline"
+	event_ONLINE           = "online"
+	event_PAGEHIDE         = "pagehide"
+	event_PAGESHOW         = "pageshow"
+	event_RESUME           = "resume"
+	event_VISIBILITYCHANGE = "visibilitychange"
 )
 
 type adapterSignalOSChannel chan uint16
 
+// signalOSSubscriber queues the browser signals of a subscribed channel so
+// none is dropped while its receiver is busy.
+type signalOSSubscriber struct {
+	channel SignalOSChannel
+	queue   []uint16
+	wake    chan struct{}
+}
+
+var signalOS = struct {
+	subscribers []*signalOSSubscriber
+	handlers    []js.Func
+	last        uint16
+	mutex       sync.Mutex
+}{}
+
 func _signalInit(sig *Signal, bufferSize int) hestiaError.Error {
 	if sig == nil {
 		return hestiaError.ENOENT
@@ -53,17 +89,219 @@ func _signalSend(sig *Signal, data uint16) hestiaError.Error {
 	return hestiaError.OK
 }
 
+func _signalTrySend(sig *Signal, data uint16) hestiaError.Error {
+	if sig == nil {
+		return hestiaError.ENOENT
+	}
+
+	select {
+	case sig.channel <- data:
+		return hestiaError.OK
+	default:
+		return hestiaError.EAGAIN
+	}
+}
+
 func _signalWait(sig *Signal) (value uint16) {
 	if sig == nil {
 		return value
 	}
 
-	value = <-sig.channel
+	if sig.ignoreOS {
+		return <-sig.channel
+	}
+
+	// stay subscribed so the signals arriving between waits are queued
+	if sig.osChannel == nil {
+		sig.osChannel = make(SignalOSChannel, 1)
+		SignalSubscribeOS(sig.osChannel)
+	}
+
+	select {
+	case value = <-sig.channel:
+	case value = <-sig.osChannel:
+	}
+
 	return value
 }
 
 func _signalSubscribeOS(ch SignalOSChannel) {
+	var subscriber *signalOSSubscriber
+
+	if ch == nil {
+		return
+	}
+
+	signalOS.mutex.Lock()
+	defer signalOS.mutex.Unlock()
+
+	// browser listeners are installed once for the page lifetime
+	if signalOS.handlers == nil {
+		__signalInitBrowser()
+	}
+
+	subscriber = &signalOSSubscriber{
+		channel: ch,
+		wake:    make(chan struct{}, 1),
+	}
+	signalOS.subscribers = append(signalOS.subscribers, subscriber)
+
+	go __signalPump(subscriber)
 }
 
 func _signalUnsubscribeOS(ch SignalOSChannel) {
+	var i int
+
+	signalOS.mutex.Lock()
+	defer signalOS.mutex.Unlock()
+
+	for i = range signalOS.subscribers {
+		if signalOS.subscribers[i].channel != ch {
+			continue
+		}
+
+		close(signalOS.subscribers[i].wake)
+		signalOS.subscribers = append(signalOS.subscribers[:i],
+			signalOS.subscribers[i+1:]...,
+		)
+
+		return
+	}
+}
+
+// NOTE: all functions below are sub-functions. Please use the global version
+// since it has proper guarding like `nil` object checking.
+
+func __signalBroadcast(event SignalBrowserEvent) {
+	var subscriber *signalOSSubscriber
+	var signal uint16
+	var size int
+
+	signal = _getBrowserSignal(event)
+	if signal == 0 {
+		return
+	}
+
+	signalOS.mutex.Lock()
+	defer signalOS.mutex.Unlock()
+
+	// page transitions fire a few events together
+	if signal == signalOS.last {
+		return
+	}
+
+	// an unqueued signal must not swallow the next identical one
+	if len(signalOS.subscribers) == 0 {
+		return
+	}
+	signalOS.last = signal
+
+	// queue instead of sending to never block the Javascript callback
+	for _, subscriber = range signalOS.subscribers {
+		size = len(subscriber.queue)
+		if size == 0 || subscriber.queue[size-1] != signal {
+			subscriber.queue = append(subscriber.queue, signal)
+		}
+
+		select {
+		case subscriber.wake <- struct{}{}:
+		default:
+		}
+	}
+}
+
+func __signalPump(subscriber *signalOSSubscriber) {
+	var signal uint16
+	var ok bool
+
+	for {
+		signalOS.mutex.Lock()
+		if len(subscriber.queue) == 0 {
+			signalOS.mutex.Unlock()
+
+			_, ok = <-subscriber.wake
+			if !ok {
+				return // unsubscribed
+			}
+
+			continue
+		}
+		signal = subscriber.queue[0]
+		signalOS.mutex.Unlock()
+
+		select {
+		case subscriber.channel <- signal:
+			signalOS.mutex.Lock()
+			subscriber.queue = subscriber.queue[1:]
+			signalOS.mutex.Unlock()
+		case _, ok = <-subscriber.wake:
+			if !ok {
+				return // unsubscribed
+			}
+		}
+	}
+}
+
+func __signalInitBrowser() {
+	var global, document js.Value
+
+	global = js.Global()
+	document = global.Get(id_JS_DOCUMENT)
+
+	__signalListen(document, event_VISIBILITYCHANGE, func(e js.Value) {
+		if document.Get(id_JS_VISIBILITY_STATE).String() == id_JS_HIDDEN {
+			__signalBroadcast(SIGNAL_BROWSER_HIDDEN)
+			return
+		}
+
+		__signalBroadcast(SIGNAL_BROWSER_VISIBLE)
+	})
+
+	__signalListen(global, event_PAGEHIDE, func(e js.Value) {
+		__signalBroadcast(SIGNAL_BROWSER_PAGEHIDE)
+	})
+
+	__signalListen(global, event_PAGESHOW, func(e js.Value) {
+		// skip initial page load
+		if !e.Get(id_JS_PERSISTED).Truthy() {
+			return
+		}
+
+		__signalBroadcast(SIGNAL_BROWSER_PAGESHOW)
+	})
+
+	__signalListen(document, event_FREEZE, func(e js.Value) {
+		__signalBroadcast(SIGNAL_BROWSER_FREEZE)
+	})
+
+	__signalListen(document, event_RESUME, func(e js.Value) {
+		__signalBroadcast(SIGNAL_BROWSER_RESUME)
+	})
+
+	__signalListen(global, event_BEFOREUNLOAD, func(e js.Value) {
+		__signalBroadcast(SIGNAL_BROWSER_BEFOREUNLOAD)
+	})
+
+	__signalListen(global, event_ONLINE, func(e js.Value) {
+		__signalBroadcast(SIGNAL_BROWSER_ONLINE)
+	})
+
+	__signalListen(global, event_OFFLINE, func(e js.Value) {
+		__signalBroadcast(SIGNAL_BROWSER_OFFLINE)
+	})
+}
+
+func __signalListen(target js.Value, name string, fx func(js.Value)) {
+	var handler js.Func
+
+	handler = js.FuncOf(func(this js.Value, args []js.Value) any {
+		// broadcast synchronously to preserve the events order. It never
+		// blocks since the signals are only queued.
+		fx(args[0])
+
+		return nil
+	})
+
+	target.Call(id_JS_ADD_EVENT_LISTENER, name, handler)
+	signalOS.handlers = append(signalOS.handlers, handler)
 }
@@ -0,0 +1,105 @@
+// Copyright 2022 "Holloway" Chew, Kean Ho <hollowaykeanho@gmail.com>
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package hestiaWASM
+
+import (
+	"hestiaGo/hestiaError"
+	"sync"
+)
+
+// AbortController is the hestiaWASM adapter for Javascript AbortController.
+//
+// The purpose is to detach a group of asynchronous operations at once. It can
+// be shared by many EventListeners (see `EventListener.Signal`) and any
+// Javascript API accepting an `AbortSignal` (see `GetAbortSignal(...)`).
+//
+// Like its Javascript counterpart, an AbortController **CAN ONLY BE ABORTED
+// ONCE**. Create a new AbortController after `Abort(...)` for new operations.
+//
+// The zero value is ready for use:
+//       controller := &hestiaWASM.AbortController{}
+type AbortController struct {
+	object  *Object
+	handles []*EventHandle
+	aborted bool
+	mutex   sync.Mutex
+}
+
+// Abort aborts all the operations attached to a given AbortController.
+//
+// All attached EventListeners are removed from their owner and their handlers
+// are released. Other Javascript operations (e.g. `fetch`) holding the
+// controller's signal are rejected by Javascript with `AbortError`.
+//
+// It accepts the following parameters:
+//   1. `controller` - the AbortController to abort.
+//
+// It shall returns:
+//   1. hestiaError.OK | `0` - operation successful.
+//   2. hestiaError.EOWNERDEAD - given `controller` is `nil`.
+//   3. hestiaError.EALREADY - given `controller` was already aborted.
+//   4. hestiaError.EPFNOSUPPORT | `96` - operating in a non-WASM CPU.
+func Abort(controller *AbortController) hestiaError.Error {
+	if controller == nil {
+		return hestiaError.EOWNERDEAD
+	}
+
+	return _abort(controller)
+}
+
+// GetAbortSignal obtains the Javascript `AbortSignal` of an AbortController.
+//
+// This is meant for passing the signal into Javascript APIs directly (e.g. as
+// `signal` option via `Call(...)`).
+//
+// It accepts the following parameters:
+//   1. `controller` - the AbortController.
+//
+// It shall returns:
+//   1. hestiaWASM.Object, hestiaError.OK - the Javascript `AbortSignal`.
+//   2. `nil`, hestiaError.EOWNERDEAD - given `controller` is `nil`.
+//   3. `nil`, hestiaError.EPFNOSUPPORT | `96` - operating in a non-WASM CPU.
+func GetAbortSignal(controller *AbortController) (*Object, hestiaError.Error) {
+	if controller == nil {
+		return nil, hestiaError.EOWNERDEAD
+	}
+
+	return _getAbortSignal(controller)
+}
+
+// IsAborted checks a given AbortController was aborted.
+//
+// It accepts the following parameters:
+//   1. `controller` - the AbortController to inspect.
+//
+// It shall returns:
+//   1. `true`, hestiaError.OK - the controller was aborted.
+//   2. `false`, hestiaError.OK - the controller is still usable.
+//   3. `false`, hestiaError.EOWNERDEAD - given `controller` is `nil`.
+//   4. `false`, hestiaError.EPFNOSUPPORT | `96` - operating in a non-WASM
+//                                                 CPU.
+func IsAborted(controller *AbortController) (bool, hestiaError.Error) {
+	if controller == nil {
+		return false, hestiaError.EOWNERDEAD
+	}
+
+	return _isAborted(controller)
+}
@@ -0,0 +1,149 @@
+// Copyright 2022 "Holloway" Chew, Kean Ho <hollowaykeanho@gmail.com>
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+//go:build wasm
+// +build wasm
+
+package hestiaWASM
+
+import (
+	"hestiaGo/hestiaError"
+	"syscall/js"
+)
+
+func _abort(controller *AbortController) hestiaError.Error {
+	var handle *EventHandle
+
+	controller.mutex.Lock()
+	defer controller.mutex.Unlock()
+
+	if controller.aborted {
+		return hestiaError.EALREADY
+	}
+
+	// Javascript detaches all listeners holding the signal by itself.
+	__initAbortController(controller)
+	controller.object.value.Call(id_JS_ABORT)
+	controller.aborted = true
+
+	// release all attached handlers
+	for _, handle = range controller.handles {
+		if handle.handler == nil {
+			continue
+		}
+
+		__release(FUNC_KIND_EVENT_LISTENER, handle.handler.function)
+		handle.handler = nil
+
+		if handle.listener.handle == handle {
+			handle.listener.handle = nil
+			handle.listener.bridge = nil
+		}
+	}
+	controller.handles = nil
+
+	return hestiaError.OK
+}
+
+func _getAbortSignal(controller *AbortController) (*Object, hestiaError.Error) {
+	var ret js.Value
+
+	controller.mutex.Lock()
+	__initAbortController(controller)
+	ret = controller.object.value.Get(id_JS_SIGNAL)
+	controller.mutex.Unlock()
+
+	return &Object{
+		value: &ret,
+	}, hestiaError.OK
+}
+
+func _isAborted(controller *AbortController) (verdict bool, err hestiaError.Error) {
+	controller.mutex.Lock()
+	verdict = controller.aborted
+	controller.mutex.Unlock()
+
+	return verdict, hestiaError.OK
+}
+
+// NOTE: all functions below are sub-functions. Please use the global version
+// since it has proper guarding like `nil` object checking.
+
+func __abortSignal(controller *AbortController) (signal js.Value, err hestiaError.Error) {
+	controller.mutex.Lock()
+	defer controller.mutex.Unlock()
+
+	if controller.aborted {
+		return js.Undefined(), hestiaError.ECANCELED
+	}
+
+	__initAbortController(controller)
+
+	return controller.object.value.Get(id_JS_SIGNAL), hestiaError.OK
+}
+
+func __attachAbortHandle(controller *AbortController, handle *EventHandle) hestiaError.Error {
+	controller.mutex.Lock()
+	defer controller.mutex.Unlock()
+
+	// the signal already detached the listener on the Javascript side
+	if controller.aborted {
+		return hestiaError.ECANCELED
+	}
+
+	controller.handles = append(controller.handles, handle)
+
+	return hestiaError.OK
+}
+
+func __detachAbortHandle(controller *AbortController, handle *EventHandle) bool {
+	var i int
+
+	controller.mutex.Lock()
+	defer controller.mutex.Unlock()
+
+	for i = range controller.handles {
+		if controller.handles[i] != handle {
+			continue
+		}
+
+		controller.handles = append(controller.handles[:i],
+			controller.handles[i+1:]...,
+		)
+
+		return true
+	}
+
+	// handle was never attached or was already released by Abort(...)
+	return false
+}
+
+func __initAbortController(controller *AbortController) {
+	var ret js.Value
+
+	if controller.object != nil {
+		return
+	}
+
+	ret = Global().value.Get(id_JS_ABORT_CONTROLLER).New()
+	controller.object = &Object{
+		value: &ret,
+	}
+}
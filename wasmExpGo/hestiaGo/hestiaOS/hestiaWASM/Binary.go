@@ -0,0 +1,190 @@
+// Copyright 2022 "Holloway" Chew, Kean Ho <hollowaykeanho@gmail.com>
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package hestiaWASM
+
+import (
+	"hestiaGo/hestiaError"
+)
+
+// CopyBytesToGo copies bytes from a Javascript binary Object into a byte slice.
+//
+// The given `src` can be any of Javascript `ArrayBuffer`, `DataView` or typed
+// arrays (e.g. `Uint8Array`, `Float32Array`). Its raw bytes are copied as it
+// is up to the smaller length between `dst` and `src`.
+//
+// It accepts the following parameters:
+//   1. `dst` - the Go byte slice to receive the bytes.
+//   2. `src` - the Javascript binary Object.
+//
+// It shall returns:
+//   1. copied length, hestiaError.OK - operation successful.
+//   2. `0`, hestiaError.EOWNERDEAD - given `src` is unusable.
+//   3. `0`, hestiaError.EPROTOTYPE - given `src` is not a binary Object.
+//   4. `0`, hestiaError.EPFNOSUPPORT | `96` - operating in a non-WASM CPU.
+func CopyBytesToGo(dst []byte, src *Object) (int, hestiaError.Error) {
+	return _copyBytesToGo(dst, src)
+}
+
+// CopyBytesToJS copies bytes from a byte slice into a Javascript binary Object.
+//
+// The given `dst` can be any of Javascript `ArrayBuffer`, `DataView` or typed
+// arrays (e.g. `Uint8Array`, `Float32Array`). The raw bytes are copied as it is
+// up to the smaller length between `dst` and `src`.
+//
+// It accepts the following parameters:
+//   1. `dst` - the Javascript binary Object to receive the bytes.
+//   2. `src` - the Go byte slice.
+//
+// It shall returns:
+//   1. copied length, hestiaError.OK - operation successful.
+//   2. `0`, hestiaError.EOWNERDEAD - given `dst` is unusable.
+//   3. `0`, hestiaError.EPROTOTYPE - given `dst` is not a binary Object.
+//   4. `0`, hestiaError.EPFNOSUPPORT | `96` - operating in a non-WASM CPU.
+func CopyBytesToJS(dst *Object, src []byte) (int, hestiaError.Error) {
+	return _copyBytesToJS(dst, src)
+}
+
+// NewArrayBuffer creates a Javascript `ArrayBuffer` filled with a byte slice.
+//
+// It accepts the following parameters:
+//   1. `data` - the Go byte slice.
+//
+// It shall returns:
+//   1. hestiaWASM.Object, hestiaError.OK - the created `ArrayBuffer`. It is
+//      zero-length for an empty `data`.
+//   2. `nil`, hestiaError.EPFNOSUPPORT | `96` - operating in a non-WASM CPU.
+func NewArrayBuffer(data []byte) (*Object, hestiaError.Error) {
+	return _newArrayBuffer(data)
+}
+
+// NewDataView creates a Javascript `DataView` over a given binary Object.
+//
+// The `DataView` shares the same memory with the given `buffer` so changes
+// are visible on both sides.
+//
+// It accepts the following parameters:
+//   1. `buffer` - the Javascript `ArrayBuffer` or typed array.
+//
+// It shall returns:
+//   1. hestiaWASM.Object, hestiaError.OK - the created `DataView`.
+//   2. `nil`, hestiaError.EOWNERDEAD - given `buffer` is unusable.
+//   3. `nil`, hestiaError.EPROTOTYPE - given `buffer` is not a binary Object.
+//   4. `nil`, hestiaError.EPFNOSUPPORT | `96` - operating in a non-WASM CPU.
+func NewDataView(buffer *Object) (*Object, hestiaError.Error) {
+	return _newDataView(buffer)
+}
+
+// NewFloat32Array creates a Javascript `Float32Array` filled with given values.
+//
+// The values are copied in bulk using the platform's native byte order
+// (little-endian for WASM).
+//
+// It accepts the following parameters:
+//   1. `data` - the Go float32 slice.
+//
+// It shall returns:
+//   1. hestiaWASM.Object, hestiaError.OK - the created `Float32Array`. It is
+//      zero-length for an empty `data`.
+//   2. `nil`, hestiaError.EPFNOSUPPORT | `96` - operating in a non-WASM CPU.
+func NewFloat32Array(data []float32) (*Object, hestiaError.Error) {
+	return _newFloat32Array(data)
+}
+
+// NewInt32Array creates a Javascript `Int32Array` filled with given values.
+//
+// The values are copied in bulk using the platform's native byte order
+// (little-endian for WASM).
+//
+// It accepts the following parameters:
+//   1. `data` - the Go int32 slice.
+//
+// It shall returns:
+//   1. hestiaWASM.Object, hestiaError.OK - the created `Int32Array`. It is
+//      zero-length for an empty `data`.
+//   2. `nil`, hestiaError.EPFNOSUPPORT | `96` - operating in a non-WASM CPU.
+func NewInt32Array(data []int32) (*Object, hestiaError.Error) {
+	return _newInt32Array(data)
+}
+
+// NewUint8Array creates a Javascript `Uint8Array` filled with a byte slice.
+//
+// It accepts the following parameters:
+//   1. `data` - the Go byte slice.
+//
+// It shall returns:
+//   1. hestiaWASM.Object, hestiaError.OK - the created `Uint8Array`. It is
+//      zero-length for an empty `data`.
+//   2. `nil`, hestiaError.EPFNOSUPPORT | `96` - operating in a non-WASM CPU.
+func NewUint8Array(data []byte) (*Object, hestiaError.Error) {
+	return _newUint8Array(data)
+}
+
+// ReadBytes copies all bytes of a Javascript binary Object into a new slice.
+//
+// It is the allocating version of `CopyBytesToGo(...)`.
+//
+// It accepts the following parameters:
+//   1. `src` - the Javascript binary Object.
+//
+// It shall returns:
+//   1. []byte, hestiaError.OK - operation successful.
+//   2. `nil`, hestiaError.EOWNERDEAD - given `src` is unusable.
+//   3. `nil`, hestiaError.EPROTOTYPE - given `src` is not a binary Object.
+//   4. `nil`, hestiaError.EPFNOSUPPORT | `96` - operating in a non-WASM CPU.
+func ReadBytes(src *Object) ([]byte, hestiaError.Error) {
+	return _readBytes(src)
+}
+
+// ReadFloat32Array copies a Javascript binary Object into a new float32 slice.
+//
+// The raw bytes are interpreted using the platform's native byte order
+// (little-endian for WASM).
+//
+// It accepts the following parameters:
+//   1. `src` - the Javascript binary Object (e.g. `Float32Array`).
+//
+// It shall returns:
+//   1. []float32, hestiaError.OK - operation successful.
+//   2. `nil`, hestiaError.EOWNERDEAD - given `src` is unusable.
+//   3. `nil`, hestiaError.EPROTOTYPE - given `src` is not a binary Object.
+//   4. `nil`, hestiaError.EMSGSIZE - the byte length is not a multiple of 4.
+//   5. `nil`, hestiaError.EPFNOSUPPORT | `96` - operating in a non-WASM CPU.
+func ReadFloat32Array(src *Object) ([]float32, hestiaError.Error) {
+	return _readFloat32Array(src)
+}
+
+// ReadInt32Array copies a Javascript binary Object into a new int32 slice.
+//
+// The raw bytes are interpreted using the platform's native byte order
+// (little-endian for WASM).
+//
+// It accepts the following parameters:
+//   1. `src` - the Javascript binary Object (e.g. `Int32Array`).
+//
+// It shall returns:
+//   1. []int32, hestiaError.OK - operation successful.
+//   2. `nil`, hestiaError.EOWNERDEAD - given `src` is unusable.
+//   3. `nil`, hestiaError.EPROTOTYPE - given `src` is not a binary Object.
+//   4. `nil`, hestiaError.EMSGSIZE - the byte length is not a multiple of 4.
+//   5. `nil`, hestiaError.EPFNOSUPPORT | `96` - operating in a non-WASM CPU.
+func ReadInt32Array(src *Object) ([]int32, hestiaError.Error) {
+	return _readInt32Array(src)
+}
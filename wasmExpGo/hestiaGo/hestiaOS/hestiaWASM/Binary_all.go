@@ -0,0 +1,68 @@
+// Copyright 2022 "Holloway" Chew, Kean Ho <hollowaykeanho@gmail.com>
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+//go:build !wasm
+// +build !wasm
+
+package hestiaWASM
+
+import (
+	"hestiaGo/hestiaError"
+)
+
+func _copyBytesToGo(dst []byte, src *Object) (int, hestiaError.Error) {
+	return 0, hestiaError.EPFNOSUPPORT
+}
+
+func _copyBytesToJS(dst *Object, src []byte) (int, hestiaError.Error) {
+	return 0, hestiaError.EPFNOSUPPORT
+}
+
+func _newArrayBuffer(data []byte) (*Object, hestiaError.Error) {
+	return nil, hestiaError.EPFNOSUPPORT
+}
+
+func _newDataView(buffer *Object) (*Object, hestiaError.Error) {
+	return nil, hestiaError.EPFNOSUPPORT
+}
+
+func _newFloat32Array(data []float32) (*Object, hestiaError.Error) {
+	return nil, hestiaError.EPFNOSUPPORT
+}
+
+func _newInt32Array(data []int32) (*Object, hestiaError.Error) {
+	return nil, hestiaError.EPFNOSUPPORT
+}
+
+func _newUint8Array(data []byte) (*Object, hestiaError.Error) {
+	return nil, hestiaError.EPFNOSUPPORT
+}
+
+func _readBytes(src *Object) ([]byte, hestiaError.Error) {
+	return nil, hestiaError.EPFNOSUPPORT
+}
+
+func _readFloat32Array(src *Object) ([]float32, hestiaError.Error) {
+	return nil, hestiaError.EPFNOSUPPORT
+}
+
+func _readInt32Array(src *Object) ([]int32, hestiaError.Error) {
+	return nil, hestiaError.EPFNOSUPPORT
+}
@@ -0,0 +1,249 @@
+// Copyright 2022 "Holloway" Chew, Kean Ho <hollowaykeanho@gmail.com>
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+//go:build wasm
+// +build wasm
+
+package hestiaWASM
+
+import (
+	"hestiaGo/hestiaError"
+	"syscall/js"
+	"unsafe"
+)
+
+const (
+	binary_WORD_SIZE = 4
+)
+
+func _copyBytesToGo(dst []byte, src *Object) (int, hestiaError.Error) {
+	var view js.Value
+	var ok bool
+
+	if IsObjectOK(src) != hestiaError.OK {
+		return 0, hestiaError.EOWNERDEAD
+	}
+
+	view, ok = __toUint8Array(*(src.value))
+	if !ok {
+		return 0, hestiaError.EPROTOTYPE
+	}
+
+	return js.CopyBytesToGo(dst, view), hestiaError.OK
+}
+
+func _copyBytesToJS(dst *Object, src []byte) (int, hestiaError.Error) {
+	var view js.Value
+	var ok bool
+
+	if IsObjectOK(dst) != hestiaError.OK {
+		return 0, hestiaError.EOWNERDEAD
+	}
+
+	view, ok = __toUint8Array(*(dst.value))
+	if !ok {
+		return 0, hestiaError.EPROTOTYPE
+	}
+
+	return js.CopyBytesToJS(view, src), hestiaError.OK
+}
+
+func _newArrayBuffer(data []byte) (*Object, hestiaError.Error) {
+	var ret js.Value
+
+	ret = __newBinary(id_JS_UINT8_ARRAY, data, len(data)).Get(id_JS_BUFFER)
+
+	return &Object{
+		value: &ret,
+	}, hestiaError.OK
+}
+
+func _newDataView(buffer *Object) (*Object, hestiaError.Error) {
+	var view, ret js.Value
+	var ok bool
+
+	if IsObjectOK(buffer) != hestiaError.OK {
+		return nil, hestiaError.EOWNERDEAD
+	}
+
+	view, ok = __toUint8Array(*(buffer.value))
+	if !ok {
+		return nil, hestiaError.EPROTOTYPE
+	}
+
+	ret = Global().value.Get(id_JS_DATA_VIEW).New(
+		view.Get(id_JS_BUFFER),
+		view.Get(id_JS_BYTE_OFFSET),
+		view.Get(id_JS_BYTE_LENGTH),
+	)
+
+	return &Object{
+		value: &ret,
+	}, hestiaError.OK
+}
+
+func _newFloat32Array(data []float32) (*Object, hestiaError.Error) {
+	var ret js.Value
+	var raw []byte
+
+	// an empty slice has no first element to point at
+	if len(data) > 0 {
+		raw = unsafe.Slice((*byte)(unsafe.Pointer(&data[0])),
+			len(data)*binary_WORD_SIZE,
+		)
+	}
+
+	ret = __newBinary(id_JS_FLOAT32_ARRAY, raw, len(data))
+
+	return &Object{
+		value: &ret,
+	}, hestiaError.OK
+}
+
+func _newInt32Array(data []int32) (*Object, hestiaError.Error) {
+	var ret js.Value
+	var raw []byte
+
+	// an empty slice has no first element to point at
+	if len(data) > 0 {
+		raw = unsafe.Slice((*byte)(unsafe.Pointer(&data[0])),
+			len(data)*binary_WORD_SIZE,
+		)
+	}
+
+	ret = __newBinary(id_JS_INT32_ARRAY, raw, len(data))
+
+	return &Object{
+		value: &ret,
+	}, hestiaError.OK
+}
+
+func _newUint8Array(data []byte) (*Object, hestiaError.Error) {
+	var ret js.Value
+
+	ret = __newBinary(id_JS_UINT8_ARRAY, data, len(data))
+
+	return &Object{
+		value: &ret,
+	}, hestiaError.OK
+}
+
+func _readBytes(src *Object) ([]byte, hestiaError.Error) {
+	var view js.Value
+	var out []byte
+	var ok bool
+
+	if IsObjectOK(src) != hestiaError.OK {
+		return nil, hestiaError.EOWNERDEAD
+	}
+
+	view, ok = __toUint8Array(*(src.value))
+	if !ok {
+		return nil, hestiaError.EPROTOTYPE
+	}
+
+	out = make([]byte, view.Get(id_JS_BYTE_LENGTH).Int())
+	js.CopyBytesToGo(out, view)
+
+	return out, hestiaError.OK
+}
+
+func _readFloat32Array(src *Object) ([]float32, hestiaError.Error) {
+	var data []byte
+	var out []float32
+	var err hestiaError.Error
+
+	data, err = __readWords(src)
+	if err != hestiaError.OK || len(data) == 0 {
+		return nil, err
+	}
+
+	out = make([]float32, len(data)/binary_WORD_SIZE)
+	copy(unsafe.Slice((*byte)(unsafe.Pointer(&out[0])), len(data)), data)
+
+	return out, hestiaError.OK
+}
+
+func _readInt32Array(src *Object) ([]int32, hestiaError.Error) {
+	var data []byte
+	var out []int32
+	var err hestiaError.Error
+
+	data, err = __readWords(src)
+	if err != hestiaError.OK || len(data) == 0 {
+		return nil, err
+	}
+
+	out = make([]int32, len(data)/binary_WORD_SIZE)
+	copy(unsafe.Slice((*byte)(unsafe.Pointer(&out[0])), len(data)), data)
+
+	return out, hestiaError.OK
+}
+
+// NOTE: all functions below are sub-functions. Please use the global version
+// since it has proper guarding like `nil` object checking.
+
+func __newBinary(constructor string, data []byte, length int) js.Value {
+	var ret js.Value
+
+	ret = Global().value.Get(constructor).New(length)
+	js.CopyBytesToJS(
+		Global().value.Get(id_JS_UINT8_ARRAY).New(ret.Get(id_JS_BUFFER)),
+		data,
+	)
+
+	return ret
+}
+
+func __readWords(src *Object) (data []byte, err hestiaError.Error) {
+	data, err = ReadBytes(src)
+	if err != hestiaError.OK {
+		return nil, err
+	}
+
+	if len(data)%binary_WORD_SIZE != 0 {
+		return nil, hestiaError.EMSGSIZE
+	}
+
+	return data, hestiaError.OK
+}
+
+func __toUint8Array(value js.Value) (js.Value, bool) {
+	var global js.Value
+
+	if value.Type() != js.TypeObject {
+		return js.Undefined(), false
+	}
+
+	global = Global().value.Get(id_JS_UINT8_ARRAY)
+	switch {
+	case value.InstanceOf(global):
+		return value, true
+	case value.InstanceOf(Global().value.Get(id_JS_ARRAY_BUFFER)):
+		return global.New(value), true
+	case Global().value.Get(id_JS_ARRAY_BUFFER).Call(id_JS_IS_VIEW, value).Bool():
+		return global.New(value.Get(id_JS_BUFFER),
+			value.Get(id_JS_BYTE_OFFSET),
+			value.Get(id_JS_BYTE_LENGTH),
+		), true
+	default:
+		return js.Undefined(), false
+	}
+}
@@ -0,0 +1,89 @@
+// Copyright 2022 "Holloway" Chew, Kean Ho <hollowaykeanho@gmail.com>
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package hestiaWASM
+
+import (
+	"hestiaGo/hestiaError"
+	"hestiaGo/hestiaKernel/hestiaChainKernel"
+)
+
+// AddChainEventListener adds an EventListener that triggers a chain kernel.
+//
+// Every trigger sends its own `*Event` into the kernel's first function block
+// as `*hestiaChainKernel.Payload` (see `hestiaChainKernel.TriggerPayload`).
+// The Event is queued synchronously inside the Javascript callback so the
+// chain runs follow the exact order the events were dispatched. Example:
+//       hestiaChainKernel.Start(kernel, func(arg any) any {
+//           payload, ok := arg.(*hestiaChainKernel.Payload)
+//           if !ok {
+//               return nil // other signals
+//           }
+//
+//           event := payload.Data.(*hestiaWASM.Event)
+//           ...
+//       }, 5)
+//
+// The kernel can be started after this function. However, events dispatched
+// while the kernel is not running are dropped.
+//
+// The given listener is bridged internally without modifying its fields: its
+// Function is not used (it can be `nil`) while its Filter (if any) is still
+// executed first where `EVENT_DECISION_SKIP` stops the event from reaching
+// the kernel. Use `RemoveEventListener(...)` or the listener's Signal to
+// detach it as usual, after which the listener is no longer bridged.
+//
+// It accepts the following parameters:
+//   1. `element` - the Object to receive the EventListener behavior.
+//   2. `listener` - the EventListener behavior for attaching into `element`.
+//   3. `kernel` - the hestiaChainKernel receiving the events.
+//
+// It shall returns:
+//   1. hestiaError.OK | `0` - operation successful.
+//   2. hestiaError.ENOMEDIUM - given `listener` is `nil`.
+//   3. hestiaError.EHOSTUNREACH - given `kernel` is `nil`.
+//   4. any error from `AddEventListener(...)`.
+func AddChainEventListener(element *Object,
+	listener *EventListener,
+	kernel *hestiaChainKernel.Kernel) hestiaError.Error {
+	var bridge func(*Event)
+	var err hestiaError.Error
+
+	if listener == nil {
+		return hestiaError.ENOMEDIUM
+	}
+
+	if hestiaChainKernel.Validate(kernel) != hestiaError.OK {
+		return hestiaError.EHOSTUNREACH
+	}
+
+	// replace (not wrap) any previous bridge so re-adding triggers once
+	bridge = listener.bridge
+	listener.bridge = func(e *Event) {
+		_ = hestiaChainKernel.TriggerPayload(kernel, e)
+	}
+
+	err = AddEventListener(element, listener)
+	if err != hestiaError.OK {
+		listener.bridge = bridge
+	}
+
+	return err
+}
@@ -0,0 +1,318 @@
+// Copyright 2022 "Holloway" Chew, Kean Ho <hollowaykeanho@gmail.com>
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package hestiaWASM
+
+import (
+	"hestiaGo/hestiaError"
+	"reflect"
+)
+
+const (
+	convert_DEPTH_DEFAULT = 32
+	convert_TAG           = "js"
+	convert_TAG_SKIP      = "-"
+)
+
+// NOTE: all functions below are sub-functions. Please use the global version
+// since it has proper guarding like `nil` object checking.
+
+func __decodeMap(target reflect.Value, data any) (err hestiaError.Error) {
+	var list map[string]any
+	var item reflect.Value
+	var key string
+	var value any
+	var ok bool
+
+	if list, ok = data.(map[string]any); !ok {
+		return hestiaError.EPROTOTYPE
+	}
+
+	if target.Type().Key().Kind() != reflect.String {
+		return hestiaError.EPROTOTYPE
+	}
+
+	if target.IsNil() {
+		target.Set(reflect.MakeMapWithSize(target.Type(), len(list)))
+	}
+
+	for key, value = range list {
+		item = reflect.New(target.Type().Elem()).Elem()
+
+		err = __decodeValue(item, value)
+		if err != hestiaError.OK {
+			return err
+		}
+
+		target.SetMapIndex(reflect.ValueOf(key).Convert(target.Type().Key()),
+			item,
+		)
+	}
+
+	return hestiaError.OK
+}
+
+func __decodeSlice(target reflect.Value, data any) (err hestiaError.Error) {
+	var array []any
+	var out reflect.Value
+	var i int
+	var ok bool
+
+	if array, ok = data.([]any); !ok {
+		return hestiaError.EPROTOTYPE
+	}
+
+	out = reflect.MakeSlice(target.Type(), len(array), len(array))
+	for i = range array {
+		err = __decodeValue(out.Index(i), array[i])
+		if err != hestiaError.OK {
+			return err
+		}
+	}
+	target.Set(out)
+
+	return hestiaError.OK
+}
+
+func __decodeStruct(target reflect.Value, data any) (err hestiaError.Error) {
+	var list map[string]any
+	var field reflect.StructField
+	var name string
+	var value any
+	var i int
+	var ok bool
+
+	if list, ok = data.(map[string]any); !ok {
+		return hestiaError.EPROTOTYPE
+	}
+
+	for i = 0; i < target.NumField(); i++ {
+		field = target.Type().Field(i)
+		if !field.IsExported() {
+			continue
+		}
+
+		name = field.Tag.Get(convert_TAG)
+		switch name {
+		case convert_TAG_SKIP:
+			continue
+		case "":
+			name = field.Name
+		}
+
+		if value, ok = list[name]; !ok {
+			continue
+		}
+
+		err = __decodeValue(target.Field(i), value)
+		if err != hestiaError.OK {
+			return err
+		}
+	}
+
+	return hestiaError.OK
+}
+
+func __decodeValue(target reflect.Value, data any) (err hestiaError.Error) {
+	var number float64
+	var ok bool
+
+	if data == nil {
+		return hestiaError.OK
+	}
+
+	switch target.Kind() {
+	case reflect.Interface:
+		if target.NumMethod() != 0 {
+			return hestiaError.EPROTOTYPE
+		}
+
+		target.Set(reflect.ValueOf(data))
+	case reflect.Pointer:
+		if target.IsNil() {
+			target.Set(reflect.New(target.Type().Elem()))
+		}
+
+		return __decodeValue(target.Elem(), data)
+	case reflect.Bool:
+		var verdict bool
+
+		if verdict, ok = data.(bool); !ok {
+			return hestiaError.EPROTOTYPE
+		}
+
+		target.SetBool(verdict)
+	case reflect.String:
+		var text string
+
+		if text, ok = data.(string); !ok {
+			return hestiaError.EPROTOTYPE
+		}
+
+		target.SetString(text)
+	case reflect.Float32, reflect.Float64:
+		if number, ok = data.(float64); !ok {
+			return hestiaError.EPROTOTYPE
+		}
+
+		if target.OverflowFloat(number) {
+			return hestiaError.ERANGE
+		}
+
+		target.SetFloat(number)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if number, ok = data.(float64); !ok {
+			return hestiaError.EPROTOTYPE
+		}
+
+		if number != float64(int64(number)) || target.OverflowInt(int64(number)) {
+			return hestiaError.ERANGE
+		}
+
+		target.SetInt(int64(number))
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32,
+		reflect.Uint64, reflect.Uintptr:
+		if number, ok = data.(float64); !ok {
+			return hestiaError.EPROTOTYPE
+		}
+
+		if number < 0 ||
+			number != float64(uint64(number)) ||
+			target.OverflowUint(uint64(number)) {
+			return hestiaError.ERANGE
+		}
+
+		target.SetUint(uint64(number))
+	case reflect.Slice:
+		return __decodeSlice(target, data)
+	case reflect.Map:
+		return __decodeMap(target, data)
+	case reflect.Struct:
+		return __decodeStruct(target, data)
+	default:
+		return hestiaError.EPROTOTYPE
+	}
+
+	return hestiaError.OK
+}
+
+func __encodeValue(value reflect.Value, depth uint) (out any, err hestiaError.Error) {
+	var list map[string]any
+	var array []any
+	var field reflect.StructField
+	var name string
+	var iter *reflect.MapIter
+	var i int
+	var ok bool
+
+	if depth == 0 {
+		return nil, hestiaError.ELOOP
+	}
+
+	if !value.IsValid() {
+		return nil, hestiaError.OK
+	}
+
+	// pass Javascript values as it is
+	out, ok = _encodeNative(value)
+	if ok {
+		return out, hestiaError.OK
+	}
+
+	switch value.Kind() {
+	case reflect.Bool:
+		return value.Bool(), hestiaError.OK
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return value.Int(), hestiaError.OK
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32,
+		reflect.Uint64, reflect.Uintptr:
+		return value.Uint(), hestiaError.OK
+	case reflect.Float32, reflect.Float64:
+		return value.Float(), hestiaError.OK
+	case reflect.String:
+		return value.String(), hestiaError.OK
+	case reflect.Interface, reflect.Pointer:
+		if value.IsNil() {
+			return nil, hestiaError.OK
+		}
+
+		return __encodeValue(value.Elem(), depth-1)
+	case reflect.Slice, reflect.Array:
+		if value.Kind() == reflect.Slice && value.IsNil() {
+			return nil, hestiaError.OK
+		}
+
+		array = make([]any, value.Len())
+		for i = range array {
+			array[i], err = __encodeValue(value.Index(i), depth-1)
+			if err != hestiaError.OK {
+				return nil, err
+			}
+		}
+
+		return array, hestiaError.OK
+	case reflect.Map:
+		if value.Type().Key().Kind() != reflect.String {
+			return nil, hestiaError.EPROTOTYPE
+		}
+
+		if value.IsNil() {
+			return nil, hestiaError.OK
+		}
+
+		list = map[string]any{}
+		iter = value.MapRange()
+		for iter.Next() {
+			list[iter.Key().String()], err = __encodeValue(iter.Value(),
+				depth-1,
+			)
+			if err != hestiaError.OK {
+				return nil, err
+			}
+		}
+
+		return list, hestiaError.OK
+	case reflect.Struct:
+		list = map[string]any{}
+		for i = 0; i < value.NumField(); i++ {
+			field = value.Type().Field(i)
+			if !field.IsExported() {
+				continue
+			}
+
+			name = field.Tag.Get(convert_TAG)
+			switch name {
+			case convert_TAG_SKIP:
+				continue
+			case "":
+				name = field.Name
+			}
+
+			list[name], err = __encodeValue(value.Field(i), depth-1)
+			if err != hestiaError.OK {
+				return nil, err
+			}
+		}
+
+		return list, hestiaError.OK
+	}
+
+	return nil, hestiaError.EPROTOTYPE
+}
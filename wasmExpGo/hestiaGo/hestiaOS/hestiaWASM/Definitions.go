@@ -24,6 +24,32 @@ import (
 	"hestiaGo/hestiaError"
 )
 
+// EventDecision is the synchronous decisions returned by
+// `EventListener.Filter`.
+//
+// The decisions can be combined using bitwise OR (e.g.
+// `EVENT_DECISION_PREVENT_DEFAULT | EVENT_DECISION_STOP_PROPAGATION`).
+type EventDecision uint8
+
+// EventDecision representations ID
+const (
+	// EVENT_DECISION_NONE lets the event continues as it is.
+	EVENT_DECISION_NONE EventDecision = 0
+
+	// EVENT_DECISION_PREVENT_DEFAULT calls `preventDefault()`.
+	EVENT_DECISION_PREVENT_DEFAULT EventDecision = 1 << 0
+
+	// EVENT_DECISION_STOP_PROPAGATION calls `stopPropagation()`.
+	EVENT_DECISION_STOP_PROPAGATION EventDecision = 1 << 1
+
+	// EVENT_DECISION_STOP_IMMEDIATE_PROPAGATION calls
+	// `stopImmediatePropagation()`.
+	EVENT_DECISION_STOP_IMMEDIATE_PROPAGATION EventDecision = 1 << 2
+
+	// EVENT_DECISION_SKIP skips executing `EventListener.Function`.
+	EVENT_DECISION_SKIP EventDecision = 1 << 3
+)
+
 // EventPhase is the W3C DOM Event Flow representations.
 //
 // More info: https://www.w3.org/TR/DOM-Level-3-Events/#event-flow
@@ -66,6 +92,18 @@ type Event struct {
 	// CurrentTarget refers to this event's currently registered target.
 	CurrentTarget *Object
 
+	// Files holds the dropped Files of a drag-and-drop event.
+	//
+	// It is only populated for `drop` event and `nil` otherwise. See
+	// `GetFiles(...)` for reading from `<input type="file">`.
+	Files []*File
+
+	// Detail holds the CustomEvent's `detail` payload converted to Go.
+	//
+	// It follows `Convert(...)` conversion rules and is `nil` when the event
+	// is not a CustomEvent or its `detail` is not convertable.
+	Detail any
+
 	// DefaultPrevented indicates the `preventDefault()` was called to this event.
 	//
 	// `true` means this event was called after the `event.preventDefault()`
@@ -84,8 +122,17 @@ type Event struct {
 	IsTrusted bool
 
 	// Target refers to this event's orignally dispatched.
+	//
+	// For delegated EventListener (`EventListener.Selector`), it refers to
+	// the matched descendant instead. The actual dispatched target is kept
+	// in OriginalTarget.
 	Target *Object
 
+	// OriginalTarget refers to this event's actual dispatched target.
+	//
+	// It is the same as Target except for delegated EventListener.
+	OriginalTarget *Object
+
 	// This refers to the current object.
 	This *Object
 
@@ -96,6 +143,49 @@ type Event struct {
 
 	// Type is the case-insensitive name.
 	Type string
+
+	// Input holds the InputEvent data (e.g. `input`, `beforeinput`).
+	//
+	// It is `nil` when the event is not an InputEvent.
+	Input *InputEvent
+
+	// Keyboard holds the KeyboardEvent data (e.g. `keydown`, `keyup`).
+	//
+	// It is `nil` when the event is not a KeyboardEvent.
+	Keyboard *KeyboardEvent
+
+	// Mouse holds the MouseEvent data (e.g. `click`, `mousemove`).
+	//
+	// It is also populated for PointerEvent and WheelEvent since both are
+	// MouseEvent by Javascript specification. It is `nil` when the event is
+	// not a MouseEvent.
+	Mouse *MouseEvent
+
+	// Pointer holds the PointerEvent data (e.g. `pointerdown`).
+	//
+	// It is `nil` when the event is not a PointerEvent.
+	Pointer *PointerEvent
+
+	// Touch holds the TouchEvent data (e.g. `touchstart`).
+	//
+	// It is `nil` when the event is not a TouchEvent or the browser does not
+	// support TouchEvent.
+	Touch *TouchEvent
+
+	// Wheel holds the WheelEvent data (e.g. `wheel`).
+	//
+	// It is `nil` when the event is not a WheelEvent.
+	Wheel *WheelEvent
+}
+
+// EventHandle is the per-attachment handle of an EventListener.
+//
+// It is created by `AttachEventListener(...)` and is required by
+// `DetachEventListener(...)` to detach the exact attachment. It is ultimately
+// your responsibility to keep the EventHandle object for memory management.
+type EventHandle struct {
+	listener *EventListener
+	handler  *Object
 }
 
 // EventListener is the adapter data structure for JS.addEventListener.
@@ -104,10 +194,15 @@ type Event struct {
 // instruction for adding/removing event listener into a Javascript object.
 //
 // For keeping this adapter package sane, **ONLY ONE (1)** EventListener can
-// **ONLY BE ADDED TO ONE (1) ACTIVE OWNER AT A TIME**. If you need to serve
-// multiple common listeners with the same function, have every common
-// EventListener.Functions calls a common Go function instead. The sole reason
-// is to preserve the handler for `RemoveEventListener()` at will.
+// **ONLY BE ADDED TO ONE (1) ACTIVE OWNER AT A TIME** via
+// `AddEventListener(...)`. The sole reason is to preserve the handler for
+// `RemoveEventListener()` at will.
+//
+// If you need to serve multiple owners with the same EventListener, use
+// `AttachEventListener(...)` instead where each attachment returns its own
+// EventHandle for `DetachEventListener(...)`. For a large group of similar
+// children (e.g. list items or table rows), consider a single delegated
+// EventListener on their parent instead (see `Selector`).
 //
 // That also being said that it is ultimately your responsibility to keep the
 // EventListener object for memory management.
@@ -121,6 +216,26 @@ type EventListener struct {
 	// separate goroutine.
 	Function func(*Event)
 
+	// Filter is the optional synchronous decision function.
+	//
+	// Unlike Function, Filter is executed **INSIDE** the Javascript callback
+	// before Function is dispatched. Hence, its returned EventDecision can
+	// conditionally call `preventDefault()`, `stopPropagation()`, and
+	// `stopImmediatePropagation()` based on the Event data, or skip
+	// Function entirely.
+	//
+	// Since the Javascript event loop is blocked until Filter returns,
+	// Filter **MUST**:
+	//   1. return as soon as possible (e.g. only inspect the Event).
+	//   2. **NOT** block on channels, mutexes, `Await(...)`, network, or
+	//      anything waiting for Javascript; doing so deadlocks the page.
+	//   3. leave heavy processing to Function instead.
+	//
+	// Preventing default has no effect when Passive is `true`.
+	//
+	// Default (`nil`) is no filtering.
+	Filter func(*Event) EventDecision
+
 	// CaptureMode is the decision for "bubble" or "capture" dispatch modes.
 	//
 	// "capture" mode is where the event of the outer parent is triggered
@@ -136,7 +251,8 @@ type EventListener struct {
 	//
 	// As stated in Javascript standards, if Once is set to `true`, the
 	// function shall be triggered 1-time and **SHALL** be removed
-	// automatically.
+	// automatically. For a delegated EventListener (see Selector), only the
+	// first event matching the Selector counts.
 	//
 	// Default (`false`) is "always / not once".
 	Once bool
@@ -160,31 +276,225 @@ type EventListener struct {
 	// before hestiaWASM.Event Go format parsing.
 	PreventDefault bool
 
-	// Signal is the abort signal use to trigger an abort handling.
+	// Selector is the CSS selector for delegating the event.
 	//
-	// This is for inputting a general abort signal action into the
-	// event listener.
-	// TODO: **Requires Abort investigation needs**
+	// When set, the EventListener is only triggered when the event's
+	// dispatched target is (or is inside) a descendant of the owner
+	// matching this selector. The matched descendant is given as
+	// `Event.Target`. This allows a single EventListener on a parent to
+	// serve all its current and future children.
+	//
+	// Default (`""`) is no delegation.
+	Selector string
 
-	handler *Object
+	// Signal is the abort controller use to trigger an abort handling.
+	//
+	// When set, calling `Abort(...)` on the controller removes this
+	// EventListener from its owner and releases its handler, just like
+	// `RemoveEventListener(...)`. One AbortController can be shared by
+	// many EventListeners to detach all of them at once.
+	//
+	// Default (`nil`) is no abort handling.
+	Signal *AbortController
+
+	bridge func(*Event)
+	handle *EventHandle
 }
 
+// EventModifiers is the modifier keys state during an event.
+type EventModifiers struct {
+	// Alt is the `Alt` (`Option` on macOS) key state.
+	Alt bool
+
+	// Ctrl is the `Control` key state.
+	Ctrl bool
+
+	// Meta is the `Meta` (`Command` on macOS, `Windows` on Windows) key
+	// state.
+	Meta bool
+
+	// Shift is the `Shift` key state.
+	Shift bool
+}
+
+// EventOptions is the dispatch options for `DispatchEvent(...)`.
+//
+// The fields are matching their `Event` counterparts. The zero value is a
+// non-bubbling, non-cancellable, and non-composed event.
+type EventOptions struct {
+	// IsBubble sets the event to bubble up to its ancestors.
+	IsBubble bool
+
+	// IsCancellable sets the event to be cancellable by `preventDefault()`.
+	IsCancellable bool
+
+	// IsComposed sets the event to bubble between shadow DOM and regular DOM.
+	IsComposed bool
+}
+
+// FuncKind is the category of Go functions exposed to Javascript.
+//
+// Every Go function exposed to Javascript (`js.Func`) holds a Go-side
+// reference until it is released. Hence, hestiaWASM keeps a live count per
+// category for diagnosing leaks. See `CountFuncs(...)`.
+type FuncKind uint8
+
+// FuncKind representations ID
+const (
+	FUNC_KIND_ALL             FuncKind = 0
+	FUNC_KIND_EVENT_LISTENER  FuncKind = 1
+	FUNC_KIND_PROMISE         FuncKind = 2
+	FUNC_KIND_PROMISE_HANDLER FuncKind = 3
+	FUNC_KIND_AWAIT           FuncKind = 4
+	FUNC_KIND_OBSERVER        FuncKind = 5
+	FUNC_KIND_WEBSOCKET       FuncKind = 6
+	FUNC_KIND_INDEXEDDB       FuncKind = 7
+	FUNC_KIND_TIMER           FuncKind = 8
+	func_KIND_MAX             FuncKind = 9
+)
+
 // Global() returns the DOM global Object.
 func Global() *Object {
 	return _global()
 }
 
+// HTMLPosition is the insertion position relative to an element.
+//
+// It follows the Javascript `insertAdjacentHTML` position values. Given an
+// element `<p>`, the positions are:
+//
+//   <!-- HTML_POSITION_BEFORE_BEGIN -->
+//   <p>
+//     <!-- HTML_POSITION_AFTER_BEGIN -->
+//     existing content
+//     <!-- HTML_POSITION_BEFORE_END -->
+//   </p>
+//   <!-- HTML_POSITION_AFTER_END -->
+type HTMLPosition uint8
+
+// HTMLPosition representations ID
+const (
+	HTML_POSITION_BEFORE_BEGIN HTMLPosition = 1
+	HTML_POSITION_AFTER_BEGIN  HTMLPosition = 2
+	HTML_POSITION_BEFORE_END   HTMLPosition = 3
+	HTML_POSITION_AFTER_END    HTMLPosition = 4
+)
+
 // Head() retruns the DOM `<head>` Object got from Document().
 func Head() *Object {
 	return _head()
 }
 
+// InputEvent is the Go format of Javascript InputEvent.
+type InputEvent struct {
+	// Data is the inserted characters. It can be empty (e.g. deletion).
+	Data string
+
+	// InputType is the type of change (e.g. `insertText`,
+	// `deleteContentBackward`).
+	InputType string
+
+	// IsComposing indicates the event is fired during a composition
+	// session (e.g. IME).
+	IsComposing bool
+}
+
+// KeyboardEvent is the Go format of Javascript KeyboardEvent.
+type KeyboardEvent struct {
+	// Key is the key value considering modifiers and layout (e.g. `"a"`,
+	// `"A"`, `"Enter"`).
+	Key string
+
+	// Code is the physical key regardless of layout (e.g. `"KeyA"`).
+	Code string
+
+	// Location is the key location (`0`: standard, `1`: left, `2`:
+	// right, `3`: numpad).
+	Location uint8
+
+	// Modifiers is the modifier keys state.
+	Modifiers EventModifiers
+
+	// Repeat indicates the key is held down and auto-repeating.
+	Repeat bool
+
+	// IsComposing indicates the event is fired during a composition
+	// session (e.g. IME).
+	IsComposing bool
+}
+
+// MouseEvent is the Go format of Javascript MouseEvent.
+//
+// All coordinates are in CSS pixels.
+type MouseEvent struct {
+	// ClientX and ClientY are the coordinates relative to the viewport.
+	ClientX float64
+	ClientY float64
+
+	// MovementX and MovementY are the deltas since the last `mousemove`.
+	MovementX float64
+	MovementY float64
+
+	// OffsetX and OffsetY are the coordinates relative to the target's
+	// padding edge.
+	OffsetX float64
+	OffsetY float64
+
+	// PageX and PageY are the coordinates relative to the whole document.
+	PageX float64
+	PageY float64
+
+	// ScreenX and ScreenY are the coordinates relative to the screen.
+	ScreenX float64
+	ScreenY float64
+
+	// Button is the button causing the event (`0`: main, `1`: auxiliary,
+	// `2`: secondary, `3`: back, `4`: forward).
+	Button int16
+
+	// Buttons is the bitmask of the pressed buttons (`1`: main, `2`:
+	// secondary, `4`: auxiliary, `8`: back, `16`: forward).
+	Buttons uint16
+
+	// Modifiers is the modifier keys state.
+	Modifiers EventModifiers
+}
+
 // Object is the hestiaWASM adapter to syscall/js.Value object.
 //
 // The purpose is to ensure most of hestiaWASM is built on a stable environment
 // while waiting for "syscall/js" to stabilize its own development.
 type Object adapter
 
+// PointerEvent is the Go format of Javascript PointerEvent.
+type PointerEvent struct {
+	// PointerType is the device type (`"mouse"`, `"pen"` or `"touch"`).
+	PointerType string
+
+	// PointerID is the unique identifier of the pointer.
+	PointerID int
+
+	// Width and Height are the contact geometry in CSS pixels.
+	Width  float64
+	Height float64
+
+	// Pressure is the normalized pressure from `0` to `1`.
+	Pressure float64
+
+	// TangentialPressure is the normalized barrel pressure from `-1` to `1`.
+	TangentialPressure float64
+
+	// TiltX and TiltY are the pen tilt angles from `-90` to `90` degrees.
+	TiltX float64
+	TiltY float64
+
+	// Twist is the pen rotation from `0` to `359` degrees.
+	Twist float64
+
+	// IsPrimary indicates the pointer is the primary pointer of its type.
+	IsPrimary bool
+}
+
 // Promise is the hestiaWASM adapter for Javscript Promise object.
 //
 // The goal is to become an adapter to Javascript Promise functionality for
@@ -243,3 +553,78 @@ type Promise struct {
 
 	object *Object
 }
+
+// Touch is the Go format of a single Javascript Touch point.
+//
+// All coordinates are in CSS pixels.
+type Touch struct {
+	// Identifier is the unique identifier of the touch point.
+	Identifier int
+
+	// ClientX and ClientY are the coordinates relative to the viewport.
+	ClientX float64
+	ClientY float64
+
+	// PageX and PageY are the coordinates relative to the whole document.
+	PageX float64
+	PageY float64
+
+	// ScreenX and ScreenY are the coordinates relative to the screen.
+	ScreenX float64
+	ScreenY float64
+
+	// RadiusX and RadiusY are the contact ellipse radius.
+	RadiusX float64
+	RadiusY float64
+
+	// Force is the normalized pressure from `0` to `1`.
+	Force float64
+
+	// Target is the element where the touch point started.
+	Target *Object
+}
+
+// TouchEvent is the Go format of Javascript TouchEvent.
+type TouchEvent struct {
+	// Touches are all the touch points currently on the surface.
+	Touches []*Touch
+
+	// TargetTouches are the touch points started on the current target.
+	TargetTouches []*Touch
+
+	// ChangedTouches are the touch points changed in this event.
+	ChangedTouches []*Touch
+
+	// Modifiers is the modifier keys state.
+	Modifiers EventModifiers
+}
+
+// ValueType is the Javascript data type of a hestiaWASM.Object.
+//
+// It follows the Javascript `typeof` operator with `null` separated from
+// Javascript Object.
+type ValueType uint8
+
+// ValueType representations ID
+const (
+	VALUE_TYPE_UNKNOWN   ValueType = 0
+	VALUE_TYPE_UNDEFINED ValueType = 1
+	VALUE_TYPE_NULL      ValueType = 2
+	VALUE_TYPE_BOOLEAN   ValueType = 3
+	VALUE_TYPE_NUMBER    ValueType = 4
+	VALUE_TYPE_STRING    ValueType = 5
+	VALUE_TYPE_SYMBOL    ValueType = 6
+	VALUE_TYPE_OBJECT    ValueType = 7
+	VALUE_TYPE_FUNCTION  ValueType = 8
+)
+
+// WheelEvent is the Go format of Javascript WheelEvent.
+type WheelEvent struct {
+	// DeltaX, DeltaY and DeltaZ are the scroll amounts in DeltaMode unit.
+	DeltaX float64
+	DeltaY float64
+	DeltaZ float64
+
+	// DeltaMode is the delta unit (`0`: pixel, `1`: line, `2`: page).
+	DeltaMode uint8
+}
@@ -0,0 +1,256 @@
+// Copyright 2022 "Holloway" Chew, Kean Ho <hollowaykeanho@gmail.com>
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package hestiaWASM
+
+import (
+	"errors"
+	"hestiaGo/hestiaError"
+	"io"
+	"time"
+)
+
+const (
+	file_CHUNK_SIZE_DEFAULT = 64 * 1024
+)
+
+// File is the Go format of Javascript File.
+//
+// It is obtained from `GetFiles(...)` for `<input type="file">` or from
+// `Event.Files` for drag-and-drop `drop` event. The contents are only read on
+// demand via `ReadFile(...)`, `ReadFileChunks(...)`, or `NewFileReader(...)`.
+type File struct {
+	// Name is the file name without path.
+	Name string
+
+	// MIME is the file's MIME type (e.g. `"image/png"`).
+	//
+	// It is `""` when the browser cannot guess the type.
+	MIME string
+
+	// Size is the file size in bytes.
+	Size uint64
+
+	// LastModified is the last modified time.
+	LastModified time.Time
+
+	object *Object
+}
+
+// FileReadOptions is the reading options for File.
+type FileReadOptions struct {
+	// Progress is the optional progress reporting function.
+	//
+	// It is called synchronously in the reading goroutine after each chunk
+	// with the total bytes read so far and the File's size.
+	Progress func(read uint64, total uint64)
+
+	// Limit is the maximum accepted File's size in bytes.
+	//
+	// Default (`0`) is no limit.
+	Limit uint64
+
+	// ChunkSize is the size of each read in bytes.
+	//
+	// Default (`0`) is 64 KiB.
+	ChunkSize uint64
+
+	// Timeout is the maximum waiting duration for each chunk.
+	//
+	// Default (`0`) waits forever.
+	Timeout time.Duration
+}
+
+// GetFiles obtains the list of Files from a given Object.
+//
+// The Object is either an `<input type="file">` element or a Javascript
+// `DataTransfer` (e.g. `dataTransfer` of a drag event). For drag-and-drop, it
+// is simpler to use the `Event.Files` from the `drop` EventListener instead.
+// Do remember to set `PreventDefault` on both `dragover` and `drop`
+// EventListeners, otherwise the browser opens the dropped file by itself.
+//
+// It accepts the following parameters:
+//   1. `element` - the Object holding the `files` list.
+//
+// It shall returns:
+//   1. []*File, hestiaError.OK | `0` - operation successful. The list can be
+//                                      empty.
+//   2. `nil`, hestiaError.EOWNERDEAD - given `element` is unusable.
+//   3. `nil`, hestiaError.ENODATA - given `element` has no `files` list.
+//   4. `nil`, hestiaError.EPFNOSUPPORT | `96` - operating in a non-WASM CPU.
+func GetFiles(element *Object) ([]*File, hestiaError.Error) {
+	return _getFiles(element)
+}
+
+// NewFileReader creates an io.ReadCloser streaming a File's contents in
+// chunks.
+//
+// Each chunk is only read when the previous one is consumed. Hence, the
+// memory footprint is bounded by `FileReadOptions.ChunkSize` regardless of
+// the File's size.
+//
+// Like `Await(...)`, the returned io.ReadCloser **SHALL NOT** be read inside a
+// Javascript callback. The reading error is a Go error carrying the
+// `hestiaError.ERROR_*` message of `ReadFileChunks(...)`'s codes. Close it
+// to stop the reading early; otherwise, it must be read until `io.EOF`.
+//
+// It accepts the following parameters:
+//   1. `file` - the File to read.
+//   2. `options` - the reading options. Can be `nil` for default.
+//
+// It shall returns:
+//   1. io.ReadCloser, hestiaError.OK | `0` - operation successful.
+//   2. `nil`, hestiaError.EOWNERDEAD - given `file` is unusable.
+//   3. `nil`, hestiaError.EFBIG - given `file` is larger than
+//                                 `options.Limit`.
+func NewFileReader(file *File, options *FileReadOptions) (io.ReadCloser, hestiaError.Error) {
+	var reader *io.PipeReader
+	var writer *io.PipeWriter
+
+	if file == nil || file.object == nil {
+		return nil, hestiaError.EOWNERDEAD
+	}
+
+	if options != nil && options.Limit != 0 && file.Size > options.Limit {
+		return nil, hestiaError.EFBIG
+	}
+
+	reader, writer = io.Pipe()
+	go func() {
+		var err hestiaError.Error
+
+		err = ReadFileChunks(file, options, func(chunk []byte) hestiaError.Error {
+			_, e := writer.Write(chunk)
+			if e != nil {
+				// reader was closed by its consumer
+				return hestiaError.ECANCELED
+			}
+
+			return hestiaError.OK
+		})
+
+		writer.CloseWithError(__toGoError(err))
+	}()
+
+	return reader, hestiaError.OK
+}
+
+// ReadFile reads the entire contents of a File into memory.
+//
+// Like `Await(...)`, it **SHALL NOT** be called inside a Javascript callback.
+// Use `options.Limit` to protect the memory from huge Files.
+//
+// It accepts the following parameters:
+//   1. `file` - the File to read.
+//   2. `options` - the reading options. Can be `nil` for default.
+//
+// It shall returns:
+//   1. []byte, hestiaError.OK | `0` - operation successful.
+//   2. `nil`, any error from `ReadFileChunks(...)`.
+func ReadFile(file *File, options *FileReadOptions) (out []byte, err hestiaError.Error) {
+	if file == nil || file.object == nil {
+		return nil, hestiaError.EOWNERDEAD
+	}
+
+	// check the limit before allocating for the entire File
+	if options != nil && options.Limit != 0 && file.Size > options.Limit {
+		return nil, hestiaError.EFBIG
+	}
+
+	out = make([]byte, 0, file.Size)
+	err = ReadFileChunks(file, options, func(chunk []byte) hestiaError.Error {
+		out = append(out, chunk...)
+		return hestiaError.OK
+	})
+	if err != hestiaError.OK {
+		return nil, err
+	}
+
+	return out, hestiaError.OK
+}
+
+// ReadFileChunks reads a File's contents chunk by chunk.
+//
+// Each chunk is read by awaiting the Javascript `Blob.arrayBuffer()` Promise
+// so it **SHALL NOT** be called inside a Javascript callback (see
+// `Await(...)`).
+//
+// It accepts the following parameters:
+//   1. `file` - the File to read.
+//   2. `options` - the reading options. Can be `nil` for default.
+//   3. `function` - the function receiving each chunk in order. Returning
+//                   non-`hestiaError.OK` stops the reading with that error.
+//
+// It shall returns:
+//   1. hestiaError.OK | `0` - operation successful.
+//   2. hestiaError.EOWNERDEAD - given `file` is unusable.
+//   3. hestiaError.ENOENT - given `function` is `nil`.
+//   4. hestiaError.EFBIG - given `file` is larger than `options.Limit`.
+//   5. hestiaError.ETIMEDOUT - a chunk was not read in `options.Timeout`.
+//   6. hestiaError.EIO - Javascript failed to read the File (e.g. the file
+//                        was deleted after selection).
+//   7. hestiaError.EOPNOTSUPP - the browser does not support
+//                               `Blob.arrayBuffer()`.
+//   8. hestiaError.EPFNOSUPPORT | `96` - operating in a non-WASM CPU.
+func ReadFileChunks(file *File,
+	options *FileReadOptions,
+	function func(chunk []byte) hestiaError.Error) hestiaError.Error {
+	if file == nil || file.object == nil {
+		return hestiaError.EOWNERDEAD
+	}
+
+	if function == nil {
+		return hestiaError.ENOENT
+	}
+
+	if options == nil {
+		options = &FileReadOptions{}
+	}
+
+	if options.Limit != 0 && file.Size > options.Limit {
+		return hestiaError.EFBIG
+	}
+
+	return _readFileChunks(file, options, function)
+}
+
+// NOTE: all functions below are sub-functions. Please use the global version
+// since it has proper guarding like `nil` object checking.
+
+func __toGoError(err hestiaError.Error) error {
+	switch err {
+	case hestiaError.OK:
+		return nil
+	case hestiaError.ECANCELED:
+		return errors.New(hestiaError.ERROR_ECANCELED)
+	case hestiaError.ECONNRESET:
+		return errors.New(hestiaError.ERROR_ECONNRESET)
+	case hestiaError.EFBIG:
+		return errors.New(hestiaError.ERROR_EFBIG)
+	case hestiaError.ETIMEDOUT:
+		return errors.New(hestiaError.ERROR_ETIMEDOUT)
+	case hestiaError.EOPNOTSUPP:
+		return errors.New(hestiaError.ERROR_EOPNOTSUPP)
+	case hestiaError.EPFNOSUPPORT:
+		return errors.New(hestiaError.ERROR_EPFNOSUPPORT)
+	default:
+		return errors.New(hestiaError.ERROR_EIO)
+	}
+}
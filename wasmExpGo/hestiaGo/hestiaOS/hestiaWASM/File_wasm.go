@@ -0,0 +1,137 @@
+// Copyright 2022 "Holloway" Chew, Kean Ho <hollowaykeanho@gmail.com>
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+//go:build wasm
+// +build wasm
+
+package hestiaWASM
+
+import (
+	"hestiaGo/hestiaError"
+	"syscall/js"
+	"time"
+)
+
+func _getFiles(element *Object) ([]*File, hestiaError.Error) {
+	var files js.Value
+
+	if IsObjectOK(element) != hestiaError.OK {
+		return nil, hestiaError.EOWNERDEAD
+	}
+
+	files = element.value.Get(id_JS_FILES)
+	if files.Type() != js.TypeObject {
+		return nil, hestiaError.ENODATA
+	}
+
+	return __newFiles(files), hestiaError.OK
+}
+
+func _readFileChunks(file *File,
+	options *FileReadOptions,
+	function func(chunk []byte) hestiaError.Error) (err hestiaError.Error) {
+	var blob, promise, ret, array js.Value
+	var chunk []byte
+	var offset, size, end uint64
+	var ok bool
+
+	if IsObjectOK(file.object) != hestiaError.OK {
+		return hestiaError.EOWNERDEAD
+	}
+
+	size = options.ChunkSize
+	if size == 0 {
+		size = file_CHUNK_SIZE_DEFAULT
+	}
+
+	for offset = 0; offset < file.Size; offset = end {
+		end = offset + size
+		if end > file.Size {
+			end = file.Size
+		}
+
+		// read the chunk via Blob.slice(...).arrayBuffer()
+		blob, ok = __call(file.object.value, id_JS_SLICE, offset, end)
+		if !ok {
+			return hestiaError.EIO
+		}
+
+		if blob.Get(id_JS_ARRAY_BUFFER_METHOD).Type() != js.TypeFunction {
+			return hestiaError.EOPNOTSUPP
+		}
+
+		promise = blob.Call(id_JS_ARRAY_BUFFER_METHOD)
+		ret, err = __await(promise, options.Timeout)
+		switch err {
+		case hestiaError.OK:
+		case hestiaError.ETIMEDOUT:
+			return err
+		default:
+			return hestiaError.EIO
+		}
+
+		array, ok = __toUint8Array(ret)
+		if !ok {
+			return hestiaError.EIO
+		}
+
+		chunk = make([]byte, array.Get(id_JS_LENGTH).Int())
+		js.CopyBytesToGo(chunk, array)
+
+		err = function(chunk)
+		if err != hestiaError.OK {
+			return err
+		}
+
+		if options.Progress != nil {
+			options.Progress(end, file.Size)
+		}
+	}
+
+	return hestiaError.OK
+}
+
+// NOTE: all functions below are sub-functions. Please use the global version
+// since it has proper guarding like `nil` object checking.
+
+func __newFiles(list js.Value) (out []*File) {
+	var i, length int
+
+	length = list.Get(id_JS_LENGTH).Int()
+	out = make([]*File, 0, length)
+
+	// each File needs its own Blob value to point at
+	for i = 0; i < length; i++ {
+		item := list.Index(i)
+		out = append(out, &File{
+			Name: item.Get(id_JS_FILE_NAME).String(),
+			MIME: item.Get(id_JS_FILE_TYPE).String(),
+			Size: uint64(item.Get(id_JS_FILE_SIZE).Float()),
+			LastModified: time.UnixMilli(
+				int64(item.Get(id_JS_FILE_LAST_MODIFIED).Float()),
+			),
+			object: &Object{
+				value: &item,
+			},
+		})
+	}
+
+	return out
+}
@@ -22,8 +22,27 @@ package hestiaWASM
 
 import (
 	"hestiaGo/hestiaError"
+	"hestiaGo/hestiaUI"
+	"html"
+	"time"
 )
 
+// AddClass adds a given class name into an element's `classList`.
+//
+// It accepts the following parameters:
+//   1. `element` - the element to receive the class.
+//   2. `name` - the class name (e.g. `"active"`).
+//
+// It shall returns:
+//   1. hestiaError.OK | `0` - operation successful.
+//   2. hestiaError.EOWNERDEAD - given `element` is unusable.
+//   3. hestiaError.ENODATA - given `name` is empty (`""`).
+//   4. hestiaError.EILSEQ - given `name` contains whitespace.
+//   5. hestiaError.EPFNOSUPPORT | `96` - operating in a non-WASM CPU.
+func AddClass(element *Object, name string) hestiaError.Error {
+	return _addClass(element, name)
+}
+
 // AddEventListener is to add an EventListener into a given hestiaWASM.Object.
 //
 // It accepts the following parameters:
@@ -38,6 +57,8 @@ import (
 //   4. hestiaError.ENOMEDIUM  - given `event` is unsable. Please check it
 //                               with `IsEventListenerOK(...)` function.
 //   5. hestiaError.EBADE - listener is already attached to an Object.
+//   6. hestiaError.EILSEQ - listener's `Selector` is not a valid CSS selector.
+//   7. hestiaError.ECANCELED - listener's `Signal` was already aborted.
 func AddEventListener(element *Object, listener *EventListener) hestiaError.Error {
 	return _addEventListener(element, listener)
 }
@@ -56,6 +77,229 @@ func Append(parent *Object, child *Object) hestiaError.Error {
 	return _append(parent, child)
 }
 
+// AttachEventListener attaches an EventListener into a given Object.
+//
+// Unlike `AddEventListener(...)`, the same EventListener can be attached to
+// many Objects at the same time. Each attachment returns its own EventHandle
+// for `DetachEventListener(...)`.
+//
+// It accepts the following parameters:
+//   1. `element` - the Object to receive the EventListener behavior.
+//   2. `listener` - the EventListener behavior for attaching into `element`.
+//
+// It shall returns:
+//   1. EventHandle, hestiaError.OK | `0` - operation successful.
+//   2. `nil`, hestiaError.EPFNOSUPPORT | `96` - operating in a non-WASM CPU.
+//   3. `nil`, hestiaError.EOWNERDEAD - given `element` is unsable. Please
+//                                      check it with `IsObjectOK(...)`.
+//   4. `nil`, hestiaError.ENOMEDIUM  - given `event` is unsable. Please check
+//                                      it with `IsEventListenerOK(...)`.
+//   5. `nil`, hestiaError.EILSEQ - listener's `Selector` is not a valid CSS
+//                                  selector.
+//   6. `nil`, hestiaError.ECANCELED - listener's `Signal` was already aborted.
+func AttachEventListener(element *Object, listener *EventListener) (*EventHandle, hestiaError.Error) {
+	return _attachEventListener(element, listener)
+}
+
+// Await blocks the calling goroutine until a Javascript Promise is settled.
+//
+// This is the reverse of `GoPromise(...)` where Go awaits Javascript instead.
+// When settled, the fulfilled value or the rejected reason is converted into
+// Go format using `Convert(...)` with its default depth. If you need the
+// Javascript Object as it is (e.g. `fetch` Response), use `AwaitObject(...)`.
+//
+// Given `promise` that is not a Javascript Promise (no `then` function) is
+// treated as an already fulfilled value like the Javascript `await` keyword.
+//
+// **IMPORTANT NOTE**: Await relies on Javascript event loop to settle the
+// Promise. Hence, it **SHALL NOT** be called directly inside a Javascript
+// callback (e.g. inside `EventListener.Function`'s wrapper or
+// `Promise.Func`'s invoker) as it blocks the event loop forever. Call it from
+// a separate goroutine instead. Both `EventListener.Function` and
+// `Promise.Func` are already executed in their own goroutine.
+//
+// It accepts the following parameters:
+//   1. `promise` - the Javascript Promise Object (e.g. from `ExecJSFunc`).
+//   2. `timeout` - the maximum waiting duration. Default (`0`) waits forever.
+//
+// It shall returns:
+//   1. value (Go format), hestiaError.OK - the Promise was fulfilled.
+//   2. reason (Go format), hestiaError.ECANCELED - the Promise was rejected.
+//                                                 The reason is `nil` when it
+//                                                 is not convertable.
+//   3. `nil`, hestiaError.ETIMEDOUT - the Promise was not settled in time.
+//   4. `nil`, hestiaError.EOWNERDEAD - given `promise` is unusable.
+//   5. `nil`, hestiaError.ELOOP - the fulfilled value is too deep or cyclic.
+//                                 See `Convert(...)`.
+//   6. `nil`, hestiaError.EPFNOSUPPORT | `96` - operating in a non-WASM CPU.
+func Await(promise *Object, timeout time.Duration) (any, hestiaError.Error) {
+	return _await(promise, timeout)
+}
+
+// AwaitObject is the unconverted version of `Await(...)`.
+//
+// It behaves exactly like `Await(...)` except the fulfilled value or the
+// rejected reason are returned as hestiaWASM.Object as it is.
+//
+// It shall returns:
+//   1. hestiaWASM.Object, hestiaError.OK - the Promise was fulfilled.
+//   2. hestiaWASM.Object, hestiaError.ECANCELED - the Promise was rejected.
+//   3. `nil`, hestiaError.ETIMEDOUT - the Promise was not settled in time.
+//   4. `nil`, hestiaError.EOWNERDEAD - given `promise` is unusable.
+//   5. `nil`, hestiaError.EPFNOSUPPORT | `96` - operating in a non-WASM CPU.
+func AwaitObject(promise *Object, timeout time.Duration) (*Object, hestiaError.Error) {
+	return _awaitObject(promise, timeout)
+}
+
+// Call invokes a method of a given Javascript Object using JS `object[method]`.
+//
+// Unlike `ExecJSFunc(...)` which is limited to global functions, Call works
+// on any Object (e.g. `element.focus()`). The return value is given as
+// hestiaWASM.Object as it is. Use `Convert(...)` or the typed getters to
+// obtain its Go format.
+//
+// It accepts the following parameters:
+//   1. `element` - the Javascript Object owning the method.
+//   2. `method` - name of the method.
+//   3. `args1, args2, ...` - arguments for the method. It must be convertable
+//                            to Javascript object (see `IsTypeConvertable()`)
+//                            or a hestiaWASM.Object.
+//
+// It shall returns:
+//   1. hestiaWASM.Object, hestiaError.OK - execution successful.
+//   2. `nil`, hestiaError.EOWNERDEAD - given `element` is unusable.
+//   3. `nil`, hestiaError.ENODATA - given `method` is empty (`""`).
+//   4. `nil`, hestiaError.EINVAL - one or more of the given argument in `args`
+//                                  is not convertable (invalid).
+//   5. `nil`, hestiaError.EPROTOTYPE - given `method` is not a Javascript
+//                                      function including its possible absence.
+//   6. `nil`, hestiaError.EPROTO - the method threw a Javascript exception.
+//   7. `nil`, hestiaError.EPFNOSUPPORT | `96` - operating in a non-WASM CPU.
+func Call(element *Object, method string, args ...any) (*Object, hestiaError.Error) {
+	return _call(element, method, args)
+}
+
+// Children obtains all the child elements of a given element.
+//
+// Only element nodes are listed (e.g. text and comment nodes are skipped). The
+// returned list is a static snapshot in document order.
+//
+// It accepts the following parameters:
+//   1. `element` - the parent element.
+//
+// It shall returns:
+//   1. []hestiaWASM.Object, hestiaError.OK - the child elements. The list is
+//                                            empty when there is none.
+//   2. `nil`, hestiaError.EOWNERDEAD - given `element` is unusable.
+//   3. `nil`, hestiaError.EPFNOSUPPORT | `96` - operating in a non-WASM CPU.
+func Children(element *Object) ([]*Object, hestiaError.Error) {
+	return _children(element)
+}
+
+// Closest obtains the nearest ancestor (including itself) matching a selector.
+//
+// It accepts the following parameters:
+//   1. `element` - the element to start the search from.
+//   2. `selector` - the CSS selector (e.g. `"div.card"`).
+//
+// It shall returns:
+//   1. hestiaWASM.Object, hestiaError.OK - the matched element.
+//   2. `nil`, hestiaError.EOWNERDEAD - given `element` is unusable.
+//   3. `nil`, hestiaError.ENODATA - given `selector` is empty (`""`).
+//   4. `nil`, hestiaError.EILSEQ - given `selector` is not a valid CSS
+//                                  selector.
+//   5. `nil`, hestiaError.ENOPROTOOPT - no element matches the `selector`.
+//   6. `nil`, hestiaError.EPFNOSUPPORT | `96` - operating in a non-WASM CPU.
+func Closest(element *Object, selector string) (*Object, hestiaError.Error) {
+	return _closest(element, selector)
+}
+
+// CloneNode duplicates a given element using JS `object.cloneNode`.
+//
+// The clone is detached from the document and event listeners added via
+// `AddEventListener(...)` are NOT copied.
+//
+// It accepts the following parameters:
+//   1. `element` - the element to duplicate.
+//   2. `deep` - `true` to duplicate its entire subtree as well.
+//
+// It shall returns:
+//   1. hestiaWASM.Object, hestiaError.OK - the cloned element.
+//   2. `nil`, hestiaError.EOWNERDEAD - given `element` is unusable.
+//   3. `nil`, hestiaError.EPFNOSUPPORT | `96` - operating in a non-WASM CPU.
+func CloneNode(element *Object, deep bool) (*Object, hestiaError.Error) {
+	return _cloneNode(element, deep)
+}
+
+// Contains checks a given child is a descendant (or itself) of a parent.
+//
+// It accepts the following parameters:
+//   1. `parent` - the element to search from.
+//   2. `child` - the element to look for.
+//
+// It shall returns:
+//   1. `true`, hestiaError.OK - `child` is inside `parent`.
+//   2. `false`, hestiaError.OK - `child` is NOT inside `parent`.
+//   3. `false`, hestiaError.EOWNERDEAD - given `parent` is unusable.
+//   4. `false`, hestiaError.ENOENT - given `child` is unusable.
+//   5. `false`, hestiaError.EPFNOSUPPORT | `96` - operating in a non-WASM CPU.
+func Contains(parent *Object, child *Object) (bool, hestiaError.Error) {
+	return _contains(parent, child)
+}
+
+// Convert recursively converts a given Javascript Object into Go format.
+//
+// The Javascript values are converted in accordance to:
+//   1. Javascript Number --> `float64`
+//   2. Javascript Boolean --> `bool`
+//   3. Javascript Null or Undefined --> `nil`
+//   4. Javascript String --> `string`
+//   5. Javascript Array --> `[]any` with each item converted.
+//   6. Javascript Object --> `map[string]any` with each own enumerable
+//                            property converted.
+//   7. Javascript Function --> `string` stating "<Javascript Function>"
+//   8. Anything else --> `string` in `syscall/js` reporting
+//
+// The conversion is guarded against self-referencing objects (cycle) and
+// overly nested objects (`depth`) since both are common in DOM objects like
+// `window` and `document`.
+//
+// It accepts the following parameters:
+//   1. `element` - the Javascript Object to convert.
+//   2. `depth` - the maximum nesting level of Array and Object. Default (`0`)
+//                is `32`.
+//
+// It shall returns:
+//   1. value (Go format), hestiaError.OK - conversion successful.
+//   2. `nil`, hestiaError.EOWNERDEAD - given `element` is unusable.
+//   3. `nil`, hestiaError.ELOOP - the `element` is cyclic or nested deeper
+//                                 than `depth`.
+//   4. `nil`, hestiaError.EPFNOSUPPORT | `96` - operating in a non-WASM CPU.
+func Convert(element *Object, depth uint) (any, hestiaError.Error) {
+	return _convert(element, depth)
+}
+
+// CountFuncs counts the live Go functions exposed to Javascript.
+//
+// It is meant for diagnosing leaks. For example, after a view is torn down,
+// the count of FUNC_KIND_EVENT_LISTENER is expected to return to its value
+// before the view was rendered.
+//
+// It accepts the following parameters:
+//   1. `kind` - the category to count. FUNC_KIND_ALL counts all categories.
+//
+// It shall returns:
+//   1. count, hestiaError.OK - operation successful.
+//   2. `0`, hestiaError.EINVAL - given `kind` is unknown.
+//   3. `0`, hestiaError.EPFNOSUPPORT | `96` - operating in a non-WASM CPU.
+func CountFuncs(kind FuncKind) (uint, hestiaError.Error) {
+	if kind >= func_KIND_MAX {
+		return 0, hestiaError.EINVAL
+	}
+
+	return _countFuncs(kind)
+}
+
 // CreateElement creates a new Javascript element from Document object.
 //
 // It accepts the following parameters:
@@ -69,6 +313,151 @@ func CreateElement(name string) (*Object, hestiaError.Error) {
 	return _createElement(name)
 }
 
+// Decode converts a given Javascript Object into a given Go data structure.
+//
+// The Javascript Object is first converted using `Convert(...)` and then
+// filled into `out`. Struct fields are mapped using the `js` field tag:
+//       type User struct {
+//           Name    string   `js:"name"`
+//           Age     uint8    `js:"age"`
+//           Tags    []string `js:"tags"`
+//           Private string   `js:"-"`
+//       }
+// Untagged exported fields use their field name as it is while unexported
+// fields are always skipped. Javascript properties without a matching field
+// are ignored and Javascript Null or Undefined leave the field untouched.
+//
+// It accepts the following parameters:
+//   1. `element` - the Javascript Object to decode.
+//   2. `out` - the pointer to the Go data structure to fill.
+//   3. `depth` - the maximum nesting level. Default (`0`) is `32`.
+//
+// It shall returns:
+//   1. hestiaError.OK | `0` - decode successful.
+//   2. hestiaError.EOWNERDEAD - given `element` is unusable.
+//   3. hestiaError.EINVAL - given `out` is `nil` or not a pointer.
+//   4. hestiaError.ELOOP - the `element` is cyclic or too deep.
+//   5. hestiaError.EPROTOTYPE - a Javascript value type is mismatched with
+//                               its Go field type.
+//   6. hestiaError.ERANGE - a Javascript Number overflows its Go field type
+//                           or is not an integer for an integer field.
+//   7. hestiaError.EPFNOSUPPORT | `96` - operating in a non-WASM CPU.
+func Decode(element *Object, out any, depth uint) hestiaError.Error {
+	return _decode(element, out, depth)
+}
+
+// Delete removes a property from a given Javascript Object.
+//
+// Deleting an absent property is not an error.
+//
+// It accepts the following parameters:
+//   1. `element` - the Javascript Object owning the property.
+//   2. `key` - name of the property.
+//
+// It shall returns:
+//   1. hestiaError.OK | `0` - operation successful.
+//   2. hestiaError.EOWNERDEAD - given `element` is unusable.
+//   3. hestiaError.ENODATA - given `key` is empty (`""`).
+//   4. hestiaError.EPERM - the property is not deletable (e.g. frozen).
+//   5. hestiaError.EPFNOSUPPORT | `96` - operating in a non-WASM CPU.
+func Delete(element *Object, key string) hestiaError.Error {
+	return _delete(element, key)
+}
+
+// DetachEventListener detaches an EventListener attachment from its Object.
+//
+// It accepts the following parameters:
+//   1. `handle` - the EventHandle from `AttachEventListener(...)`.
+//
+// It shall returns:
+//   1. hestiaError.OK | `0` - operation successful.
+//   2. hestiaError.EOWNERDEAD - given `handle` is `nil`.
+//   3. hestiaError.EBADE - the attachment was already detached (including
+//                          aborted by its listener's `Signal`).
+//   4. hestiaError.EPFNOSUPPORT | `96` - operating in a non-WASM CPU.
+func DetachEventListener(handle *EventHandle) hestiaError.Error {
+	if handle == nil {
+		return hestiaError.EOWNERDEAD
+	}
+
+	return _detachEventListener(handle)
+}
+
+// DispatchCustomEvent dispatches a CustomEvent carrying a Go payload.
+//
+// The `detail` payload is converted into Javascript value where structs
+// follow the same `js:"name"` and `js:"-"` field tags as `Decode(...)` and
+// `*Object` is passed as it is. Listeners in Go receive the payload back via
+// `Event.Detail`.
+//
+// It accepts the following parameters:
+//   1. `target` - the Object to dispatch the event.
+//   2. `name` - the name of the event (e.g. `"app:save"`).
+//   3. `detail` - the Go payload. Can be `nil`.
+//   4. `options` - the dispatch options. Can be `nil` for default.
+//
+// It shall returns:
+//   1. `false`, hestiaError.OK | `0` - event was not cancelled.
+//   2. `true`, hestiaError.OK | `0` - event was cancelled by one of its
+//                                     listeners via `preventDefault()`.
+//   3. `false`, hestiaError.EOWNERDEAD - given `target` is unusable.
+//   4. `false`, hestiaError.ENODATA - given `name` is empty.
+//   5. `false`, hestiaError.EPROTOTYPE | `91` - `detail` is not convertable.
+//   6. `false`, hestiaError.ELOOP - `detail` is nested too deep (possibly
+//                                   cyclic).
+//   7. `false`, hestiaError.EPROTO - Javascript rejected the dispatch.
+//   8. `false`, hestiaError.EPFNOSUPPORT | `96` - operating in a non-WASM CPU.
+func DispatchCustomEvent(target *Object,
+	name string,
+	detail any,
+	options *EventOptions) (bool, hestiaError.Error) {
+	return _dispatchCustomEvent(target, name, detail, options)
+}
+
+// DispatchEvent dispatches a generic Event from a given Object.
+//
+// Since the Javascript `dispatchEvent` is synchronous, all the listeners'
+// Javascript side are executed before this function returns. However, Go
+// EventListener.Function are executed asynchronously.
+//
+// It accepts the following parameters:
+//   1. `target` - the Object to dispatch the event.
+//   2. `name` - the name of the event (e.g. `"change"`).
+//   3. `options` - the dispatch options. Can be `nil` for default.
+//
+// It shall returns:
+//   1. `false`, hestiaError.OK | `0` - event was not cancelled.
+//   2. `true`, hestiaError.OK | `0` - event was cancelled by one of its
+//                                     listeners via `preventDefault()`.
+//   3. `false`, hestiaError.EOWNERDEAD - given `target` is unusable.
+//   4. `false`, hestiaError.ENODATA - given `name` is empty.
+//   5. `false`, hestiaError.EPROTO - Javascript rejected the dispatch.
+//   6. `false`, hestiaError.EPFNOSUPPORT | `96` - operating in a non-WASM CPU.
+func DispatchEvent(target *Object,
+	name string,
+	options *EventOptions) (bool, hestiaError.Error) {
+	return _dispatchEvent(target, name, options)
+}
+
+// EscapeHTML converts a given untrusted text into markup-safe HTML codes.
+//
+// The special characters `<`, `>`, `&`, `'` and `"` are converted into their
+// HTML entities so the output can never be interpreted as markup when fed
+// into `SetHTML(...)` or `InsertAdjacentHTML(...)`. This function works on all
+// platforms.
+//
+// If you do not need to mix the text with your own markup, prefer `SetText(...)`
+// or `InsertAdjacentText(...)` instead.
+//
+// It accepts the following parameters:
+//   1. `text` - the untrusted text.
+//
+// It shall returns:
+//   1. the escaped HTML codes.
+func EscapeHTML(text string) []byte {
+	return []byte(html.EscapeString(text))
+}
+
 // ExecJSFunc executes a Javascript function synchonously using JS.Invoke.
 //
 // It accepts the following parameters:
@@ -81,14 +470,8 @@ func CreateElement(name string) (*Object, hestiaError.Error) {
 //
 // By default, the function skip the return value processing and always return
 // as `nil`. However, should `withRet` is set to `true`, this function shall
-// convert the following Javascript values back to Go format in accordance to:
-//   1. Javascript Number --> `float64`
-//   2. Javascript Boolean --> `bool`
-//   3. Javascript Null or Undefined --> `nil`
-//   4. Javascript String --> `string`
-//   5. Javascript Object --> `string` stating "<Javascript Object>"
-//   6. Javascript Function --> `string` stating "<Javascript Function>"
-//   7. Anything else --> `string` in `syscall/js` reporting
+// convert the return value back to Go format using `Convert(...)` with its
+// default depth.
 //
 // It shall returns:
 //   1. value (Go format), hestiaError.OK - execution successful with return
@@ -98,11 +481,32 @@ func CreateElement(name string) (*Object, hestiaError.Error) {
 //                                  is not convertable (invalid).
 //   4. `nil`, hestiaError.EPROTOTYPE - given query (`name`) is not a Javascript
 //                                      function including its possible absence.
-//   5. `nil`, hestiaError.EPFNOSUPPORT | `96` - operating in a non-WASM CPU.
+//   5. `nil`, hestiaError.ELOOP - the return value is too deep or cyclic. See
+//                                 `Convert(...)`. **NOTE**: the Javascript
+//                                 function was already executed so its side
+//                                 effects remain. Do not retry blindly; set
+//                                 `withRet` to `false` for such functions.
+//   6. `nil`, hestiaError.EPFNOSUPPORT | `96` - operating in a non-WASM CPU.
 func ExecJSFunc(withRet bool, name string, args ...any) (any, hestiaError.Error) {
 	return _execJSFunc(withRet, name, args)
 }
 
+// FirstChild obtains the first child element of a given element.
+//
+// Only element nodes are considered (e.g. text and comment nodes are skipped).
+//
+// It accepts the following parameters:
+//   1. `element` - the parent element.
+//
+// It shall returns:
+//   1. hestiaWASM.Object, hestiaError.OK - the first child element.
+//   2. `nil`, hestiaError.EOWNERDEAD - given `element` is unusable.
+//   3. `nil`, hestiaError.ENOPROTOOPT - `element` has no child element.
+//   4. `nil`, hestiaError.EPFNOSUPPORT | `96` - operating in a non-WASM CPU.
+func FirstChild(element *Object) (*Object, hestiaError.Error) {
+	return _firstChild(element)
+}
+
 // Get obtains a child element from a given parent element.
 //
 // It accepts the following parameters:
@@ -118,6 +522,78 @@ func Get(parent *Object, query string) *Object {
 	return _get(parent, query)
 }
 
+// GetAttribute obtains the value of an element's attribute.
+//
+// It accepts the following parameters:
+//   1. `element` - the element to read from.
+//   2. `name` - the attribute name (e.g. `"aria-label"`).
+//
+// It shall returns:
+//   1. value, hestiaError.OK - the attribute value.
+//   2. `""`, hestiaError.EOWNERDEAD - given `element` is unusable.
+//   3. `""`, hestiaError.ENODATA - given `name` is empty (`""`).
+//   4. `""`, hestiaError.ENOPROTOOPT - the attribute is absent.
+//   5. `""`, hestiaError.EPFNOSUPPORT | `96` - operating in a non-WASM CPU.
+func GetAttribute(element *Object, name string) (string, hestiaError.Error) {
+	return _getAttribute(element, name)
+}
+
+// GetBool obtains a Javascript Boolean property from a given Object.
+//
+// It accepts the following parameters:
+//   1. `element` - the Javascript Object owning the property.
+//   2. `key` - name of the property.
+//
+// It shall returns:
+//   1. value, hestiaError.OK - operation successful.
+//   2. `false`, hestiaError.EOWNERDEAD - given `element` is unusable.
+//   3. `false`, hestiaError.ENODATA - given `key` is empty (`""`).
+//   4. `false`, hestiaError.ENOPROTOOPT - the property is Null or Undefined.
+//   5. `false`, hestiaError.EPROTOTYPE - the property is not a Boolean.
+//   6. `false`, hestiaError.EPFNOSUPPORT | `96` - operating in a non-WASM CPU.
+func GetBool(element *Object, key string) (bool, hestiaError.Error) {
+	return _getBool(element, key)
+}
+
+// GetComputedCSS obtains the final computed CSS property value of an element.
+//
+// Unlike the inline style set by `SetCSS(...)`, the computed value is the one
+// resolved by the browser after applying all stylesheets, inheritance and
+// inline styles. CSS variables (e.g. `--main-padding`) are supported.
+//
+// It accepts the following parameters:
+//   1. `element` - the element to inspect.
+//   2. `property` - the CSS property name in kebab-case (e.g. `"font-size"`).
+//
+// It shall returns:
+//   1. value, hestiaError.OK - the computed value.
+//   2. `""`, hestiaError.EOWNERDEAD - given `element` is unusable.
+//   3. `""`, hestiaError.ENOTNAM | `118` - given `property` is empty.
+//   4. `""`, hestiaError.ENOPROTOOPT - the property has no computed value.
+//   5. `""`, hestiaError.EPFNOSUPPORT | `96` - operating in a non-WASM CPU.
+func GetComputedCSS(element *Object, property string) (string, hestiaError.Error) {
+	return _getComputedCSS(element, property)
+}
+
+// GetDataset obtains the value of an element's `data-*` attribute.
+//
+// The `key` is the `dataset` camelCase form (e.g. `"userId"` for the
+// `data-user-id` attribute).
+//
+// It accepts the following parameters:
+//   1. `element` - the element to read from.
+//   2. `key` - the dataset key.
+//
+// It shall returns:
+//   1. value, hestiaError.OK - the dataset value.
+//   2. `""`, hestiaError.EOWNERDEAD - given `element` is unusable.
+//   3. `""`, hestiaError.ENODATA - given `key` is empty (`""`).
+//   4. `""`, hestiaError.ENOPROTOOPT - the dataset key is absent.
+//   5. `""`, hestiaError.EPFNOSUPPORT | `96` - operating in a non-WASM CPU.
+func GetDataset(element *Object, key string) (string, hestiaError.Error) {
+	return _getDataset(element, key)
+}
+
 // GetElementByID obtains an element through a given Javascript ID.
 //
 // It accepts the following parameters:
@@ -130,6 +606,87 @@ func GetElementByID(id string) *Object {
 	return _getElementByID(id)
 }
 
+// GetFloat obtains a Javascript Number property from a given Object.
+//
+// It accepts the following parameters:
+//   1. `element` - the Javascript Object owning the property.
+//   2. `key` - name of the property.
+//
+// It shall returns:
+//   1. value, hestiaError.OK - operation successful.
+//   2. `0`, hestiaError.EOWNERDEAD - given `element` is unusable.
+//   3. `0`, hestiaError.ENODATA - given `key` is empty (`""`).
+//   4. `0`, hestiaError.ENOPROTOOPT - the property is Null or Undefined.
+//   5. `0`, hestiaError.EPROTOTYPE - the property is not a Number.
+//   6. `0`, hestiaError.EPFNOSUPPORT | `96` - operating in a non-WASM CPU.
+func GetFloat(element *Object, key string) (float64, hestiaError.Error) {
+	return _getFloat(element, key)
+}
+
+// GetHTML obtains a given element's InnerHTML.
+//
+// It accepts the following parameters:
+//   1. `element` - the element to read from.
+//
+// It shall returns:
+//   1. HTML codes, hestiaError.OK - operation successful.
+//   2. `nil`, hestiaError.EOWNERDEAD - given `element` is unusable.
+//   3. `nil`, hestiaError.EPFNOSUPPORT | `96` - operating in a non-WASM CPU.
+func GetHTML(element *Object) ([]byte, hestiaError.Error) {
+	return _getHTML(element)
+}
+
+// GetInt obtains a Javascript Number property as integer from a given Object.
+//
+// It accepts the following parameters:
+//   1. `element` - the Javascript Object owning the property.
+//   2. `key` - name of the property.
+//
+// It shall returns:
+//   1. value, hestiaError.OK - operation successful.
+//   2. `0`, hestiaError.EOWNERDEAD - given `element` is unusable.
+//   3. `0`, hestiaError.ENODATA - given `key` is empty (`""`).
+//   4. `0`, hestiaError.ENOPROTOOPT - the property is Null or Undefined.
+//   5. `0`, hestiaError.EPROTOTYPE - the property is not a Number.
+//   6. `0`, hestiaError.ERANGE - the Number is not an integer.
+//   7. `0`, hestiaError.EPFNOSUPPORT | `96` - operating in a non-WASM CPU.
+func GetInt(element *Object, key string) (int, hestiaError.Error) {
+	return _getInt(element, key)
+}
+
+// GetString obtains a Javascript String property from a given Object.
+//
+// It accepts the following parameters:
+//   1. `element` - the Javascript Object owning the property.
+//   2. `key` - name of the property.
+//
+// It shall returns:
+//   1. value, hestiaError.OK - operation successful.
+//   2. `""`, hestiaError.EOWNERDEAD - given `element` is unusable.
+//   3. `""`, hestiaError.ENODATA - given `key` is empty (`""`).
+//   4. `""`, hestiaError.ENOPROTOOPT - the property is Null or Undefined.
+//   5. `""`, hestiaError.EPROTOTYPE - the property is not a String.
+//   6. `""`, hestiaError.EPFNOSUPPORT | `96` - operating in a non-WASM CPU.
+func GetString(element *Object, key string) (string, hestiaError.Error) {
+	return _getString(element, key)
+}
+
+// GetText obtains a given element's text content (JS `textContent`).
+//
+// All markup are stripped and only the text of the element and its subtree
+// are returned.
+//
+// It accepts the following parameters:
+//   1. `element` - the element to read from.
+//
+// It shall returns:
+//   1. text, hestiaError.OK - operation successful.
+//   2. `""`, hestiaError.EOWNERDEAD - given `element` is unusable.
+//   3. `""`, hestiaError.EPFNOSUPPORT | `96` - operating in a non-WASM CPU.
+func GetText(element *Object) (string, hestiaError.Error) {
+	return _getText(element)
+}
+
 // GoPromise registers a given Promise into Javascript function.
 //
 // This function only registers the given Promise into Javascript domain making
@@ -137,13 +694,17 @@ func GetElementByID(id string) *Object {
 //
 // Its return value here is meant to report the registration status only.
 //
+// Once no longer needed, use `UnregisterPromise(...)` to remove it from
+// Javascript domain and release its resources.
+//
 // It accepts the following parameters:
 //   1. `promise` - the hestiaWASM.Promise to execute.
 //
 // It shall returns:
 //   1. hestiaError.OK | `0` - scheduling was successful.
 //   2. All hestiaErrors from `IsPromiseOK()` - failed usability test.
-//   3. hestiaError.EPFNOSUPPORT | `96` - operating in a non-WASM CPU.
+//   3. hestiaError.EBADE - promise is already registered.
+//   4. hestiaError.EPFNOSUPPORT | `96` - operating in a non-WASM CPU.
 func GoPromise(promise *Promise) (err hestiaError.Error) {
 	err = IsPromiseOK(promise)
 	if err != hestiaError.OK {
@@ -153,6 +714,98 @@ func GoPromise(promise *Promise) (err hestiaError.Error) {
 	return _goPromise(promise)
 }
 
+// HasAttribute checks a given element is having a given attribute.
+//
+// It accepts the following parameters:
+//   1. `element` - the element to inspect.
+//   2. `name` - the attribute name.
+//
+// It shall returns:
+//   1. `true`, hestiaError.OK - the attribute is present.
+//   2. `false`, hestiaError.OK - the attribute is absent.
+//   3. `false`, hestiaError.EOWNERDEAD - given `element` is unusable.
+//   4. `false`, hestiaError.ENODATA - given `name` is empty (`""`).
+//   5. `false`, hestiaError.EPFNOSUPPORT | `96` - operating in a non-WASM CPU.
+func HasAttribute(element *Object, name string) (bool, hestiaError.Error) {
+	return _hasAttribute(element, name)
+}
+
+// HasClass checks a given element's `classList` is having a given class name.
+//
+// It accepts the following parameters:
+//   1. `element` - the element to inspect.
+//   2. `name` - the class name.
+//
+// It shall returns:
+//   1. `true`, hestiaError.OK - the class is present.
+//   2. `false`, hestiaError.OK - the class is absent.
+//   3. `false`, hestiaError.EOWNERDEAD - given `element` is unusable.
+//   4. `false`, hestiaError.ENODATA - given `name` is empty (`""`).
+//   5. `false`, hestiaError.EPFNOSUPPORT | `96` - operating in a non-WASM CPU.
+func HasClass(element *Object, name string) (bool, hestiaError.Error) {
+	return _hasClass(element, name)
+}
+
+// InsertAdjacentHTML parses and inserts HTML codes relative to an element.
+//
+// Unlike `SetHTML(...)`, the existing content of the element is preserved. Use
+// `EscapeHTML(...)` on any untrusted data before mixing it into `html`.
+//
+// It accepts the following parameters:
+//   1. `element` - the reference element.
+//   2. `position` - the insertion position. See `HTMLPosition` constants.
+//   3. `html` - the pointer of the byte slice containing the HTML codes.
+//
+// It shall returns:
+//   1. hestiaError.OK | `0` - operation successful.
+//   2. hestiaError.EOWNERDEAD - given `element` is unusable.
+//   3. hestiaError.ENODATA - given `html` is `nil`.
+//   4. hestiaError.EINVAL - given `position` is unknown.
+//   5. hestiaError.EPERM - the position is outside of a parent-less element.
+//   6. hestiaError.EPFNOSUPPORT | `96` - operating in a non-WASM CPU.
+func InsertAdjacentHTML(element *Object, position HTMLPosition, html *[]byte) hestiaError.Error {
+	return _insertAdjacentHTML(element, position, html)
+}
+
+// InsertAdjacentText inserts a plain text relative to an element.
+//
+// The text is never interpreted as markup, making it safe for untrusted data.
+//
+// It accepts the following parameters:
+//   1. `element` - the reference element.
+//   2. `position` - the insertion position. See `HTMLPosition` constants.
+//   3. `text` - the text to insert.
+//
+// It shall returns:
+//   1. hestiaError.OK | `0` - operation successful.
+//   2. hestiaError.EOWNERDEAD - given `element` is unusable.
+//   3. hestiaError.EINVAL - given `position` is unknown.
+//   4. hestiaError.EPERM - the position is outside of a parent-less element.
+//   5. hestiaError.EPFNOSUPPORT | `96` - operating in a non-WASM CPU.
+func InsertAdjacentText(element *Object, position HTMLPosition, text string) hestiaError.Error {
+	return _insertAdjacentText(element, position, text)
+}
+
+// InsertBefore inserts a child element before a reference element.
+//
+// It accepts the following parameters:
+//   1. `parent` - the element to receive new element.
+//   2. `child` - the element for inserting.
+//   3. `reference` - the existing child of `parent` to insert before. If
+//                    `nil`, `child` is appended at the end like `Append(...)`.
+//
+// It shall returns:
+//   1. hestiaError.OK | `0` - operation successful.
+//   2. hestiaError.EOWNERDEAD - given `parent` is unusable.
+//   3. hestiaError.ENOENT - given `child` is unusable.
+//   4. hestiaError.EINVAL - given `reference` is unusable, not a child of
+//                           `parent`, or the insertion is not permitted
+//                           (e.g. inserting an ancestor into itself).
+//   5. hestiaError.EPFNOSUPPORT | `96` - operating in a non-WASM CPU.
+func InsertBefore(parent *Object, child *Object, reference *Object) hestiaError.Error {
+	return _insertBefore(parent, child, reference)
+}
+
 // IsEventListenerOK checks a hestiaWASM.EventListener is a stub or is operable.
 //
 // It accepts the following parameters:
@@ -172,6 +825,20 @@ func IsEventListenerOK(element *EventListener) hestiaError.Error {
 	return _isEventListenerOK(element)
 }
 
+// IsNull checks a given Object is Javascript Null.
+//
+// It accepts the following parameters:
+//   1. `element` - the Javascript Object to inspect.
+//
+// It shall returns:
+//   1. `true`, hestiaError.OK - the Object is Null.
+//   2. `false`, hestiaError.OK - the Object is NOT Null.
+//   3. `false`, hestiaError.EOWNERDEAD - given `element` is unusable.
+//   4. `false`, hestiaError.EPFNOSUPPORT | `96` - operating in a non-WASM CPU.
+func IsNull(element *Object) (bool, hestiaError.Error) {
+	return _isNull(element)
+}
+
 // IsObjectOK checks a hestiaWASM.Object is a stub or is operable.
 //
 // It accepts the following parameters:
@@ -230,6 +897,214 @@ func IsTypeConvertable(element any) hestiaError.Error {
 	return _isTypeConvertable(element)
 }
 
+// IsUndefined checks a given Object is Javascript Undefined.
+//
+// It accepts the following parameters:
+//   1. `element` - the Javascript Object to inspect.
+//
+// It shall returns:
+//   1. `true`, hestiaError.OK - the Object is Undefined.
+//   2. `false`, hestiaError.OK - the Object is NOT Undefined.
+//   3. `false`, hestiaError.EOWNERDEAD - given `element` is unusable.
+//   4. `false`, hestiaError.EPFNOSUPPORT | `96` - operating in a non-WASM CPU.
+func IsUndefined(element *Object) (bool, hestiaError.Error) {
+	return _isUndefined(element)
+}
+
+// Matches checks a given element is matching a given CSS selector.
+//
+// It accepts the following parameters:
+//   1. `element` - the element to inspect.
+//   2. `selector` - the CSS selector (e.g. `"button:disabled"`).
+//
+// It shall returns:
+//   1. `true`, hestiaError.OK - the element matches the `selector`.
+//   2. `false`, hestiaError.OK - the element does not match the `selector`.
+//   3. `false`, hestiaError.EOWNERDEAD - given `element` is unusable.
+//   4. `false`, hestiaError.ENODATA - given `selector` is empty (`""`).
+//   5. `false`, hestiaError.EILSEQ - given `selector` is not a valid CSS
+//                                    selector.
+//   6. `false`, hestiaError.EPFNOSUPPORT | `96` - operating in a non-WASM CPU.
+func Matches(element *Object, selector string) (bool, hestiaError.Error) {
+	return _matches(element, selector)
+}
+
+// New creates a new Javascript Object using JS `new constructor(...)`.
+//
+// It accepts the following parameters:
+//   1. `constructor` - the Javascript constructor (e.g.
+//                      `Get(Global(), "Date")`).
+//   2. `args1, args2, ...` - arguments for the constructor. It must be
+//                            convertable to Javascript object (see
+//                            `IsTypeConvertable()`) or a hestiaWASM.Object.
+//
+// It shall returns:
+//   1. hestiaWASM.Object, hestiaError.OK - the created Object.
+//   2. `nil`, hestiaError.EOWNERDEAD - given `constructor` is unusable.
+//   3. `nil`, hestiaError.EINVAL - one or more of the given argument in `args`
+//                                  is not convertable (invalid).
+//   4. `nil`, hestiaError.EPROTOTYPE - given `constructor` is not a Javascript
+//                                      function.
+//   5. `nil`, hestiaError.EPROTO - the constructor threw a Javascript
+//                                  exception.
+//   6. `nil`, hestiaError.EPFNOSUPPORT | `96` - operating in a non-WASM CPU.
+func New(constructor *Object, args ...any) (*Object, hestiaError.Error) {
+	return _new(constructor, args)
+}
+
+// NextSibling obtains the next sibling element of a given element.
+//
+// Only element nodes are considered (e.g. text and comment nodes are skipped).
+//
+// It accepts the following parameters:
+//   1. `element` - the element to start from.
+//
+// It shall returns:
+//   1. hestiaWASM.Object, hestiaError.OK - the next sibling element.
+//   2. `nil`, hestiaError.EOWNERDEAD - given `element` is unusable.
+//   3. `nil`, hestiaError.ENOPROTOOPT - `element` is the last element.
+//   4. `nil`, hestiaError.EPFNOSUPPORT | `96` - operating in a non-WASM CPU.
+func NextSibling(element *Object) (*Object, hestiaError.Error) {
+	return _nextSibling(element)
+}
+
+// Parent obtains the parent node of a given element.
+//
+// It accepts the following parameters:
+//   1. `element` - the child element.
+//
+// It shall returns:
+//   1. hestiaWASM.Object, hestiaError.OK - the parent node.
+//   2. `nil`, hestiaError.EOWNERDEAD - given `element` is unusable.
+//   3. `nil`, hestiaError.ENOPROTOOPT - `element` is detached from any
+//                                       parent.
+//   4. `nil`, hestiaError.EPFNOSUPPORT | `96` - operating in a non-WASM CPU.
+func Parent(element *Object) (*Object, hestiaError.Error) {
+	return _parent(element)
+}
+
+// Prepend inserts a child element as the first child using JS `object.prepend`.
+//
+// It accepts the following parameters:
+//   1. `parent` - the element to receive new element.
+//   2. `child` - the element for prepending.
+//
+// It shall returns:
+//   1. hestiaError.OK | `0` - operation successful.
+//   2. hestiaError.EOWNERDEAD - given `parent` is unusable.
+//   3. hestiaError.ENOENT - given `child` is unusable.
+//   4. hestiaError.EINVAL - the insertion is not permitted (e.g. prepending
+//                           an ancestor into itself).
+//   5. hestiaError.EPFNOSUPPORT | `96` - operating in a non-WASM CPU.
+func Prepend(parent *Object, child *Object) hestiaError.Error {
+	return _prepend(parent, child)
+}
+
+// QuerySelector obtains the first descendant element matching a CSS selector.
+//
+// It accepts the following parameters:
+//   1. `root` - the element to search from (e.g. `Document()`).
+//   2. `selector` - the CSS selector (e.g. `"#menu > li"`).
+//
+// It shall returns:
+//   1. hestiaWASM.Object, hestiaError.OK - the first matched element.
+//   2. `nil`, hestiaError.EOWNERDEAD - given `root` is unusable.
+//   3. `nil`, hestiaError.ENODATA - given `selector` is empty (`""`).
+//   4. `nil`, hestiaError.EILSEQ - given `selector` is not a valid CSS
+//                                  selector.
+//   5. `nil`, hestiaError.ENOPROTOOPT - no element matches the `selector`.
+//   6. `nil`, hestiaError.EPFNOSUPPORT | `96` - operating in a non-WASM CPU.
+func QuerySelector(root *Object, selector string) (*Object, hestiaError.Error) {
+	return _querySelector(root, selector)
+}
+
+// QuerySelectorAll obtains all descendant elements matching a CSS selector.
+//
+// The elements are listed in document order. Unlike Javascript's live
+// `NodeList`, the returned list is a static snapshot.
+//
+// It accepts the following parameters:
+//   1. `root` - the element to search from (e.g. `Document()`).
+//   2. `selector` - the CSS selector (e.g. `"ul.items > li"`).
+//
+// It shall returns:
+//   1. []hestiaWASM.Object, hestiaError.OK - the matched elements. The list
+//                                            is empty when nothing matches.
+//   2. `nil`, hestiaError.EOWNERDEAD - given `root` is unusable.
+//   3. `nil`, hestiaError.ENODATA - given `selector` is empty (`""`).
+//   4. `nil`, hestiaError.EILSEQ - given `selector` is not a valid CSS
+//                                  selector.
+//   5. `nil`, hestiaError.EPFNOSUPPORT | `96` - operating in a non-WASM CPU.
+func QuerySelectorAll(root *Object, selector string) ([]*Object, hestiaError.Error) {
+	return _querySelectorAll(root, selector)
+}
+
+// Remove detaches a given element from its parent using JS `object.remove`.
+//
+// The element remains usable and can be re-inserted later. Removing a detached
+// element is not an error.
+//
+// It accepts the following parameters:
+//   1. `element` - the element to detach.
+//
+// It shall returns:
+//   1. hestiaError.OK | `0` - operation successful.
+//   2. hestiaError.EOWNERDEAD - given `element` is unusable.
+//   3. hestiaError.EPFNOSUPPORT | `96` - operating in a non-WASM CPU.
+func Remove(element *Object) hestiaError.Error {
+	return _remove(element)
+}
+
+// RemoveAttribute removes an attribute from a given element.
+//
+// Removing an absent attribute is not an error.
+//
+// It accepts the following parameters:
+//   1. `element` - the element to remove the attribute from.
+//   2. `name` - the attribute name.
+//
+// It shall returns:
+//   1. hestiaError.OK | `0` - operation successful.
+//   2. hestiaError.EOWNERDEAD - given `element` is unusable.
+//   3. hestiaError.ENODATA - given `name` is empty (`""`).
+//   4. hestiaError.EPFNOSUPPORT | `96` - operating in a non-WASM CPU.
+func RemoveAttribute(element *Object, name string) hestiaError.Error {
+	return _removeAttribute(element, name)
+}
+
+// RemoveClass removes a given class name from an element's `classList`.
+//
+// It accepts the following parameters:
+//   1. `element` - the element to remove the class from.
+//   2. `name` - the class name.
+//
+// It shall returns:
+//   1. hestiaError.OK | `0` - operation successful.
+//   2. hestiaError.EOWNERDEAD - given `element` is unusable.
+//   3. hestiaError.ENODATA - given `name` is empty (`""`).
+//   4. hestiaError.EILSEQ - given `name` contains whitespace.
+//   5. hestiaError.EPFNOSUPPORT | `96` - operating in a non-WASM CPU.
+func RemoveClass(element *Object, name string) hestiaError.Error {
+	return _removeClass(element, name)
+}
+
+// RemoveCSS removes an inline CSS property from a given element.
+//
+// Removing an absent property is not an error.
+//
+// It accepts the following parameters:
+//   1. `element` - the element to remove the CSS property from.
+//   2. `property` - the CSS property name in kebab-case.
+//
+// It shall returns:
+//   1. hestiaError.OK | `0` - operation successful.
+//   2. hestiaError.EOWNERDEAD - given `element` is unusable.
+//   3. hestiaError.ENOTNAM | `118` - given `property` is empty.
+//   4. hestiaError.EPFNOSUPPORT | `96` - operating in a non-WASM CPU.
+func RemoveCSS(element *Object, property string) hestiaError.Error {
+	return _removeCSS(element, property)
+}
+
 // RemoveEventListener is to remove an EventListener from a given hestiaWASM.Object.
 //
 // It accepts the following parameters:
@@ -244,12 +1119,141 @@ func IsTypeConvertable(element any) hestiaError.Error {
 //   4. hestiaError.ENOMEDIUM  - given `event` is unsable. Please check it
 //                               with `IsEventListenerOK(...)` function.
 //   5. hestiaError.EBADE - listener is not attached to any Object.
+//
+// Upon success, the Go handler exposed to Javascript is released. Hence, it is
+// safe to add the listener again with `AddEventListener(...)`.
 func RemoveEventListener(element *Object, listener *EventListener) hestiaError.Error {
 	return _removeEventListener(element, listener)
 }
 
+// ReplaceWith replaces a given element with another element in its parent.
+//
+// It accepts the following parameters:
+//   1. `element` - the element to be replaced.
+//   2. `replacement` - the element taking over `element`'s position.
+//
+// It shall returns:
+//   1. hestiaError.OK | `0` - operation successful.
+//   2. hestiaError.EOWNERDEAD - given `element` is unusable.
+//   3. hestiaError.ENOENT - given `replacement` is unusable.
+//   4. hestiaError.EINVAL - the replacement is not permitted (e.g.
+//                           replacing with an ancestor).
+//   5. hestiaError.EPFNOSUPPORT | `96` - operating in a non-WASM CPU.
+func ReplaceWith(element *Object, replacement *Object) hestiaError.Error {
+	return _replaceWith(element, replacement)
+}
+
+// Set assigns a value into a given Javascript Object's property.
+//
+// It accepts the following parameters:
+//   1. `element` - the Javascript Object owning the property.
+//   2. `key` - name of the property.
+//   3. `value` - the value. It must be convertable to Javascript object (see
+//                `IsTypeConvertable()`) or a hestiaWASM.Object.
+//
+// It shall returns:
+//   1. hestiaError.OK | `0` - operation successful.
+//   2. hestiaError.EOWNERDEAD - given `element` is unusable.
+//   3. hestiaError.ENODATA - given `key` is empty (`""`).
+//   4. hestiaError.EINVAL - given `value` is not convertable (invalid).
+//   5. hestiaError.EPERM - the property is not writable (e.g. frozen).
+//   6. hestiaError.EPFNOSUPPORT | `96` - operating in a non-WASM CPU.
+func Set(element *Object, key string, value any) hestiaError.Error {
+	return _set(element, key, value)
+}
+
+// SetAttribute sets an attribute with a given value into an element.
+//
+// For boolean attributes like `disabled`, the presence is the value. Use an
+// empty `value` to enable them and `RemoveAttribute(...)` to disable them.
+//
+// It accepts the following parameters:
+//   1. `element` - the element to receive the attribute.
+//   2. `name` - the attribute name (e.g. `"aria-hidden"`).
+//   3. `value` - the attribute value.
+//
+// It shall returns:
+//   1. hestiaError.OK | `0` - operation successful.
+//   2. hestiaError.EOWNERDEAD - given `element` is unusable.
+//   3. hestiaError.ENODATA - given `name` is empty (`""`).
+//   4. hestiaError.EILSEQ - given `name` is not a valid attribute name.
+//   5. hestiaError.EPFNOSUPPORT | `96` - operating in a non-WASM CPU.
+func SetAttribute(element *Object, name string, value string) hestiaError.Error {
+	return _setAttribute(element, name, value)
+}
+
+// SetCSS sets an inline CSS property into a given element.
+//
+// Unlike `SetStylesheet(...)`, any CSS settings in this function shall only
+// affect the given element and its subtree (via inheritance). CSS variables
+// (e.g. `--main-padding`) are supported.
+//
+// It accepts the following parameters:
+//   1. `element` - the element to receive the CSS property.
+//   2. `property` - the CSS property name in kebab-case (e.g. `"font-size"`).
+//   3. `value` - the CSS value (e.g. `"1.6rem"`).
+//
+// It shall returns:
+//   1. hestiaError.OK | `0` - operation successful.
+//   2. hestiaError.EOWNERDEAD - given `element` is unusable.
+//   3. hestiaError.ENOTNAM | `118` - given `property` is empty.
+//   4. hestiaError.ENODATA | `61` - given `value` is empty. Use
+//                                   `RemoveCSS(...)` instead.
+//   5. hestiaError.EINVAL | `22` - the browser rejected the `value`.
+//   6. hestiaError.EPFNOSUPPORT | `96` - operating in a non-WASM CPU.
+func SetCSS(element *Object, property string, value string) hestiaError.Error {
+	return _setCSS(element, property, value)
+}
+
+// SetCSSVariables sets a list of CSS variables into a given element.
+//
+// This is the element-scoped counterpart of rendering `hestiaUI.CSSVarList`
+// into a stylesheet: the variables override the page-wide ones only for the
+// given element's subtree. `nil` entries in the list are skipped.
+//
+// The list is applied in order and stops at the first failure. Hence, the
+// variables before the failed one remain applied.
+//
+// It accepts the following parameters:
+//   1. `element` - the element to receive the CSS variables.
+//   2. `list` - the list of CSS variables.
+//
+// It shall returns:
+//   1. hestiaError.OK | `0` - operation successful.
+//   2. hestiaError.EOWNERDEAD - given `element` is unusable.
+//   3. hestiaError.ENODATA | `61` - given `list` is `nil`.
+//   4. All hestiaErrors from `SetCSS()` - failed on one of the variables.
+//   5. hestiaError.EPFNOSUPPORT | `96` - operating in a non-WASM CPU.
+func SetCSSVariables(element *Object, list *hestiaUI.CSSVarList) hestiaError.Error {
+	return _setCSSVariables(element, list)
+}
+
+// SetDataset sets a `data-*` attribute with a given value into an element.
+//
+// The `key` is the `dataset` camelCase form (e.g. `"userId"` for the
+// `data-user-id` attribute).
+//
+// It accepts the following parameters:
+//   1. `element` - the element to receive the dataset value.
+//   2. `key` - the dataset key.
+//   3. `value` - the dataset value.
+//
+// It shall returns:
+//   1. hestiaError.OK | `0` - operation successful.
+//   2. hestiaError.EOWNERDEAD - given `element` is unusable.
+//   3. hestiaError.ENODATA - given `key` is empty (`""`).
+//   4. hestiaError.EILSEQ - given `key` is not a valid dataset key.
+//   5. hestiaError.EPFNOSUPPORT | `96` - operating in a non-WASM CPU.
+func SetDataset(element *Object, key string, value string) hestiaError.Error {
+	return _setDataset(element, key, value)
+}
+
 // SetHTML applies a given HTML codes into a given element's InnerHTML.
 //
+// The given HTML codes are interpreted as markup as it is. **NEVER** feed any
+// untrusted data into it without `EscapeHTML(...)`. Use `SetText(...)` for
+// plain text instead.
+//
 // It accepts the following parameters:
 //   1. `element` - the element to receive the HTML codes.
 //   2. `html` - the pointer of the byte slice containing the HTML codes.
@@ -280,3 +1284,76 @@ func SetHTML(element *Object, html *[]byte) hestiaError.Error {
 func SetStylesheet(id string, value string) hestiaError.Error {
 	return _setStylesheet(id, value)
 }
+
+// SetText applies a given text into a given element's text content.
+//
+// All existing children of the element are replaced by a single text node.
+// The text is never interpreted as markup, making it the safe choice for any
+// untrusted (e.g. user-provided) data.
+//
+// It accepts the following parameters:
+//   1. `element` - the element to receive the text.
+//   2. `text` - the text content.
+//
+// It shall returns:
+//   1. hestiaError.OK | `0` - operation successful.
+//   2. hestiaError.EOWNERDEAD - given `element` is unusable.
+//   3. hestiaError.EPFNOSUPPORT | `96` - operating in a non-WASM CPU.
+func SetText(element *Object, text string) hestiaError.Error {
+	return _setText(element, text)
+}
+
+// ToggleClass flips the presence of a class name in an element's `classList`.
+//
+// It accepts the following parameters:
+//   1. `element` - the element to toggle the class.
+//   2. `name` - the class name.
+//
+// It shall returns:
+//   1. `true`, hestiaError.OK - the class is now present.
+//   2. `false`, hestiaError.OK - the class is now absent.
+//   3. `false`, hestiaError.EOWNERDEAD - given `element` is unusable.
+//   4. `false`, hestiaError.ENODATA - given `name` is empty (`""`).
+//   5. `false`, hestiaError.EILSEQ - given `name` contains whitespace.
+//   6. `false`, hestiaError.EPFNOSUPPORT | `96` - operating in a non-WASM CPU.
+func ToggleClass(element *Object, name string) (bool, hestiaError.Error) {
+	return _toggleClass(element, name)
+}
+
+// TypeOf obtains the Javascript data type of a given Object.
+//
+// It accepts the following parameters:
+//   1. `element` - the Javascript Object to inspect.
+//
+// It shall returns:
+//   1. ValueType, hestiaError.OK - operation successful.
+//   2. VALUE_TYPE_UNKNOWN, hestiaError.EOWNERDEAD - given `element` is
+//                                                   unusable.
+//   3. VALUE_TYPE_UNKNOWN, hestiaError.EPFNOSUPPORT | `96` - operating in a
+//                                                            non-WASM CPU.
+func TypeOf(element *Object) (ValueType, hestiaError.Error) {
+	return _typeOf(element)
+}
+
+// UnregisterPromise removes a given Promise from Javascript function.
+//
+// It is the reverse of `GoPromise(...)` where the Javascript function is
+// removed from the global domain and its Go handler is released. Any running
+// Promise executions are unaffected.
+//
+// It accepts the following parameters:
+//   1. `promise` - the registered hestiaWASM.Promise.
+//
+// It shall returns:
+//   1. hestiaError.OK | `0` - operation successful.
+//   2. All hestiaErrors from `IsPromiseOK()` - failed usability test.
+//   3. hestiaError.EBADE - promise is not registered.
+//   4. hestiaError.EPFNOSUPPORT | `96` - operating in a non-WASM CPU.
+func UnregisterPromise(promise *Promise) (err hestiaError.Error) {
+	err = IsPromiseOK(promise)
+	if err != hestiaError.OK {
+		return err
+	}
+
+	return _unregisterPromise(promise)
+}
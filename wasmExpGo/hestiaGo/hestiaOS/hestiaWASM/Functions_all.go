@@ -25,6 +25,9 @@ package hestiaWASM
 
 import (
 	"hestiaGo/hestiaError"
+	"hestiaGo/hestiaUI"
+	"reflect"
+	"time"
 )
 
 // NOTE:
@@ -34,6 +37,10 @@ import (
 //   1. output == unsupported { return hestiaError.EPFNOSUPPORT }
 //   2. output == missing { return `nil` object }
 
+func _addClass(element *Object, name string) hestiaError.Error {
+	return hestiaError.EPFNOSUPPORT
+}
+
 func _addEventListener(element *Object, listener *EventListener) (err hestiaError.Error) {
 	return hestiaError.EPFNOSUPPORT
 }
@@ -42,30 +49,163 @@ func _append(parent *Object, child *Object) hestiaError.Error {
 	return hestiaError.EPFNOSUPPORT
 }
 
+func _attachEventListener(element *Object, listener *EventListener) (*EventHandle, hestiaError.Error) {
+	return nil, hestiaError.EPFNOSUPPORT
+}
+
+func _await(promise *Object, timeout time.Duration) (any, hestiaError.Error) {
+	return nil, hestiaError.EPFNOSUPPORT
+}
+
+func _awaitObject(promise *Object, timeout time.Duration) (*Object, hestiaError.Error) {
+	return nil, hestiaError.EPFNOSUPPORT
+}
+
+func _call(element *Object, method string, args []any) (*Object, hestiaError.Error) {
+	return nil, hestiaError.EPFNOSUPPORT
+}
+
+func _children(element *Object) ([]*Object, hestiaError.Error) {
+	return nil, hestiaError.EPFNOSUPPORT
+}
+
+func _closest(element *Object, selector string) (*Object, hestiaError.Error) {
+	return nil, hestiaError.EPFNOSUPPORT
+}
+
+func _cloneNode(element *Object, deep bool) (*Object, hestiaError.Error) {
+	return nil, hestiaError.EPFNOSUPPORT
+}
+
+func _contains(parent *Object, child *Object) (bool, hestiaError.Error) {
+	return false, hestiaError.EPFNOSUPPORT
+}
+
+func _convert(element *Object, depth uint) (any, hestiaError.Error) {
+	return nil, hestiaError.EPFNOSUPPORT
+}
+
+func _countFuncs(kind FuncKind) (uint, hestiaError.Error) {
+	return 0, hestiaError.EPFNOSUPPORT
+}
+
 func _createElement(name string) (child *Object, err hestiaError.Error) {
 	return nil, hestiaError.EPFNOSUPPORT
 }
 
+func _decode(element *Object, out any, depth uint) hestiaError.Error {
+	return hestiaError.EPFNOSUPPORT
+}
+
+func _delete(element *Object, key string) hestiaError.Error {
+	return hestiaError.EPFNOSUPPORT
+}
+
+func _detachEventListener(handle *EventHandle) hestiaError.Error {
+	return hestiaError.EPFNOSUPPORT
+}
+
+func _dispatchCustomEvent(target *Object,
+	name string,
+	detail any,
+	options *EventOptions) (bool, hestiaError.Error) {
+	return false, hestiaError.EPFNOSUPPORT
+}
+
+func _dispatchEvent(target *Object,
+	name string,
+	options *EventOptions) (bool, hestiaError.Error) {
+	return false, hestiaError.EPFNOSUPPORT
+}
+
+func _encodeNative(value reflect.Value) (any, bool) {
+	return nil, false
+}
+
 func _execJSFunc(withRet bool, name string, args []any) (out any, err hestiaError.Error) {
 	return nil, hestiaError.EPFNOSUPPORT
 }
 
+func _firstChild(element *Object) (*Object, hestiaError.Error) {
+	return nil, hestiaError.EPFNOSUPPORT
+}
+
 func _get(parent *Object, query string) *Object {
 	return nil
 }
 
+func _getAttribute(element *Object, name string) (string, hestiaError.Error) {
+	return "", hestiaError.EPFNOSUPPORT
+}
+
+func _getBool(element *Object, key string) (bool, hestiaError.Error) {
+	return false, hestiaError.EPFNOSUPPORT
+}
+
+func _getComputedCSS(element *Object, property string) (string, hestiaError.Error) {
+	return "", hestiaError.EPFNOSUPPORT
+}
+
+func _getDataset(element *Object, key string) (string, hestiaError.Error) {
+	return "", hestiaError.EPFNOSUPPORT
+}
+
 func _getElementByID(id string) *Object {
 	return nil
 }
 
+func _getFloat(element *Object, key string) (float64, hestiaError.Error) {
+	return 0, hestiaError.EPFNOSUPPORT
+}
+
+func _getHTML(element *Object) ([]byte, hestiaError.Error) {
+	return nil, hestiaError.EPFNOSUPPORT
+}
+
+func _getInt(element *Object, key string) (int, hestiaError.Error) {
+	return 0, hestiaError.EPFNOSUPPORT
+}
+
+func _getString(element *Object, key string) (string, hestiaError.Error) {
+	return "", hestiaError.EPFNOSUPPORT
+}
+
+func _getText(element *Object) (string, hestiaError.Error) {
+	return "", hestiaError.EPFNOSUPPORT
+}
+
 func _goPromise(promise *Promise) hestiaError.Error {
 	return hestiaError.EPFNOSUPPORT
 }
 
+func _hasAttribute(element *Object, name string) (bool, hestiaError.Error) {
+	return false, hestiaError.EPFNOSUPPORT
+}
+
+func _hasClass(element *Object, name string) (bool, hestiaError.Error) {
+	return false, hestiaError.EPFNOSUPPORT
+}
+
+func _insertAdjacentHTML(element *Object, position HTMLPosition, html *[]byte) hestiaError.Error {
+	return hestiaError.EPFNOSUPPORT
+}
+
+func _insertAdjacentText(element *Object, position HTMLPosition, text string) hestiaError.Error {
+	return hestiaError.EPFNOSUPPORT
+}
+
+func _insertBefore(parent *Object, child *Object, reference *Object) hestiaError.Error {
+	return hestiaError.EPFNOSUPPORT
+}
+
 func _isEventListenerOK(element *EventListener) hestiaError.Error {
 	return hestiaError.EPFNOSUPPORT
 }
 
+func _isNull(element *Object) (bool, hestiaError.Error) {
+	return false, hestiaError.EPFNOSUPPORT
+}
+
 func _isObjectOK(element *Object) hestiaError.Error {
 	return hestiaError.EPFNOSUPPORT
 }
@@ -78,10 +218,82 @@ func _isTypeConvertable(element any) hestiaError.Error {
 	return hestiaError.EPFNOSUPPORT
 }
 
+func _isUndefined(element *Object) (bool, hestiaError.Error) {
+	return false, hestiaError.EPFNOSUPPORT
+}
+
+func _matches(element *Object, selector string) (bool, hestiaError.Error) {
+	return false, hestiaError.EPFNOSUPPORT
+}
+
+func _new(constructor *Object, args []any) (*Object, hestiaError.Error) {
+	return nil, hestiaError.EPFNOSUPPORT
+}
+
+func _nextSibling(element *Object) (*Object, hestiaError.Error) {
+	return nil, hestiaError.EPFNOSUPPORT
+}
+
+func _parent(element *Object) (*Object, hestiaError.Error) {
+	return nil, hestiaError.EPFNOSUPPORT
+}
+
+func _prepend(parent *Object, child *Object) hestiaError.Error {
+	return hestiaError.EPFNOSUPPORT
+}
+
+func _querySelector(root *Object, selector string) (*Object, hestiaError.Error) {
+	return nil, hestiaError.EPFNOSUPPORT
+}
+
+func _querySelectorAll(root *Object, selector string) ([]*Object, hestiaError.Error) {
+	return nil, hestiaError.EPFNOSUPPORT
+}
+
+func _remove(element *Object) hestiaError.Error {
+	return hestiaError.EPFNOSUPPORT
+}
+
+func _removeAttribute(element *Object, name string) hestiaError.Error {
+	return hestiaError.EPFNOSUPPORT
+}
+
+func _removeClass(element *Object, name string) hestiaError.Error {
+	return hestiaError.EPFNOSUPPORT
+}
+
+func _removeCSS(element *Object, property string) hestiaError.Error {
+	return hestiaError.EPFNOSUPPORT
+}
+
 func _removeEventListener(element *Object, listener *EventListener) hestiaError.Error {
 	return hestiaError.EPFNOSUPPORT
 }
 
+func _replaceWith(element *Object, replacement *Object) hestiaError.Error {
+	return hestiaError.EPFNOSUPPORT
+}
+
+func _set(element *Object, key string, value any) hestiaError.Error {
+	return hestiaError.EPFNOSUPPORT
+}
+
+func _setAttribute(element *Object, name string, value string) hestiaError.Error {
+	return hestiaError.EPFNOSUPPORT
+}
+
+func _setCSS(element *Object, property string, value string) hestiaError.Error {
+	return hestiaError.EPFNOSUPPORT
+}
+
+func _setCSSVariables(element *Object, list *hestiaUI.CSSVarList) hestiaError.Error {
+	return hestiaError.EPFNOSUPPORT
+}
+
+func _setDataset(element *Object, key string, value string) hestiaError.Error {
+	return hestiaError.EPFNOSUPPORT
+}
+
 func _setHTML(element *Object, html *[]byte) hestiaError.Error {
 	return hestiaError.EPFNOSUPPORT
 }
@@ -89,3 +301,19 @@ func _setHTML(element *Object, html *[]byte) hestiaError.Error {
 func _setStylesheet(id string, value string) (err hestiaError.Error) {
 	return hestiaError.EPFNOSUPPORT
 }
+
+func _setText(element *Object, text string) hestiaError.Error {
+	return hestiaError.EPFNOSUPPORT
+}
+
+func _toggleClass(element *Object, name string) (bool, hestiaError.Error) {
+	return false, hestiaError.EPFNOSUPPORT
+}
+
+func _typeOf(element *Object) (ValueType, hestiaError.Error) {
+	return VALUE_TYPE_UNKNOWN, hestiaError.EPFNOSUPPORT
+}
+
+func _unregisterPromise(promise *Promise) hestiaError.Error {
+	return hestiaError.EPFNOSUPPORT
+}
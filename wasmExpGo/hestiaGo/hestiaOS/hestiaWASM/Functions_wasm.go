@@ -27,45 +27,326 @@ package hestiaWASM
 
 import (
 	"hestiaGo/hestiaError"
+	"hestiaGo/hestiaUI"
+	"reflect"
+	"strings"
+	"sync"
 	"syscall/js"
+	"time"
 	"unsafe"
 )
 
 const (
-	id_JS_ADD_EVENT_LISTENER      = "addEventListener"
-	id_JS_APPEND                  = "append"
-	id_JS_CREATE_ELEMENT          = "createElement"
-	id_JS_EVENT_BUBBLES           = "bubbles"
-	id_JS_EVENT_CANCELABLE        = "cancelable"
-	id_JS_EVENT_COMPOSED          = "composed"
-	id_JS_EVENT_CURRENT_TARGET    = "currentTarget"
-	id_JS_EVENT_DEFAULT_PREVENTED = "defaultPrevented"
-	id_JS_EVENT_PHASE             = "eventPhase"
-	id_JS_EVENT_PREVENT_DEFAULT   = "preventDefault"
-	id_JS_EVENT_OPTION_CAPTURE    = "capture"
-	id_JS_EVENT_OPTION_ONCE       = "once"
-	id_JS_EVENT_OPTION_PASSIVE    = "passive"
-	id_JS_EVENT_IS_TRUSTED        = "isTrusted"
-	id_JS_EVENT_TARGET            = "target"
-	id_JS_EVENT_TIMESTAMP         = "timeStamp"
-	id_JS_EVENT_TYPE              = "type"
-	id_JS_GET_ELEMENT_BY_ID       = "getElementById"
-	id_JS_HTML                    = "innerHTML"
-	id_JS_ID                      = "id"
-	id_JS_PROMISE                 = "Promise"
-	id_JS_REMOVE_EVENT_LISTENER   = "removeEventListener"
-	id_JS_TAG_NAME                = "tagName"
-	id_JS_TYPE                    = "type"
+	id_JS_ABORT                                  = "abort"
+	id_JS_ABORT_CONTROLLER                       = "AbortController"
+	id_JS_ADD                                    = "add"
+	id_JS_ADD_EVENT_LISTENER                     = "addEventListener"
+	id_JS_APPEND                                 = "append"
+	id_JS_ARRAY                                  = "Array"
+	id_JS_ARRAY_BUFFER                           = "ArrayBuffer"
+	id_JS_ARRAY_BUFFER_METHOD                    = "arrayBuffer"
+	id_JS_BUFFER                                 = "buffer"
+	id_JS_BYTE_LENGTH                            = "byteLength"
+	id_JS_BYTE_OFFSET                            = "byteOffset"
+	id_JS_CHILDREN                               = "children"
+	id_JS_CLASS_LIST                             = "classList"
+	id_JS_CLONE_NODE                             = "cloneNode"
+	id_JS_CLOSEST                                = "closest"
+	id_JS_CONTAINS                               = "contains"
+	id_JS_CREATE_ELEMENT                         = "createElement"
+	id_JS_CUSTOM_EVENT                           = "CustomEvent"
+	id_JS_DATASET                                = "dataset"
+	id_JS_DATA_TRANSFER                          = "dataTransfer"
+	id_JS_DATA_VIEW                              = "DataView"
+	id_JS_DISPATCH_EVENT                         = "dispatchEvent"
+	id_JS_EVENT                                  = "Event"
+	id_JS_EVENT_ALT_KEY                          = "altKey"
+	id_JS_EVENT_BUBBLES                          = "bubbles"
+	id_JS_EVENT_BUTTON                           = "button"
+	id_JS_EVENT_BUTTONS                          = "buttons"
+	id_JS_EVENT_CANCELABLE                       = "cancelable"
+	id_JS_EVENT_CHANGED_TOUCHES                  = "changedTouches"
+	id_JS_EVENT_CLIENT_X                         = "clientX"
+	id_JS_EVENT_CLIENT_Y                         = "clientY"
+	id_JS_EVENT_CODE                             = "code"
+	id_JS_EVENT_COMPOSED                         = "composed"
+	id_JS_EVENT_CTRL_KEY                         = "ctrlKey"
+	id_JS_EVENT_CURRENT_TARGET                   = "currentTarget"
+	id_JS_EVENT_DATA                             = "data"
+	id_JS_EVENT_DEFAULT_PREVENTED                = "defaultPrevented"
+	id_JS_EVENT_DELTA_MODE                       = "deltaMode"
+	id_JS_EVENT_DELTA_X                          = "deltaX"
+	id_JS_EVENT_DELTA_Y                          = "deltaY"
+	id_JS_EVENT_DELTA_Z                          = "deltaZ"
+	id_JS_EVENT_DETAIL                           = "detail"
+	id_JS_EVENT_FORCE                            = "force"
+	id_JS_EVENT_HEIGHT                           = "height"
+	id_JS_EVENT_IDENTIFIER                       = "identifier"
+	id_JS_EVENT_INPUT_TYPE                       = "inputType"
+	id_JS_EVENT_IS_COMPOSING                     = "isComposing"
+	id_JS_EVENT_IS_PRIMARY                       = "isPrimary"
+	id_JS_EVENT_KEY                              = "key"
+	id_JS_EVENT_LOCATION                         = "location"
+	id_JS_EVENT_META_KEY                         = "metaKey"
+	id_JS_EVENT_MOVEMENT_X                       = "movementX"
+	id_JS_EVENT_MOVEMENT_Y                       = "movementY"
+	id_JS_EVENT_OFFSET_X                         = "offsetX"
+	id_JS_EVENT_OFFSET_Y                         = "offsetY"
+	id_JS_EVENT_OPTION_SIGNAL                    = "signal"
+	id_JS_EVENT_PAGE_X                           = "pageX"
+	id_JS_EVENT_PAGE_Y                           = "pageY"
+	id_JS_EVENT_PHASE                            = "eventPhase"
+	id_JS_EVENT_POINTER_ID                       = "pointerId"
+	id_JS_EVENT_POINTER_TYPE                     = "pointerType"
+	id_JS_EVENT_PRESSURE                         = "pressure"
+	id_JS_EVENT_PREVENT_DEFAULT                  = "preventDefault"
+	id_JS_EVENT_OPTION_CAPTURE                   = "capture"
+	id_JS_EVENT_OPTION_PASSIVE                   = "passive"
+	id_JS_EVENT_IS_TRUSTED                       = "isTrusted"
+	id_JS_EVENT_RADIUS_X                         = "radiusX"
+	id_JS_EVENT_RADIUS_Y                         = "radiusY"
+	id_JS_EVENT_REPEAT                           = "repeat"
+	id_JS_EVENT_SCREEN_X                         = "screenX"
+	id_JS_EVENT_SCREEN_Y                         = "screenY"
+	id_JS_EVENT_SHIFT_KEY                        = "shiftKey"
+	id_JS_EVENT_STOP_IMMEDIATE_PROPAGATION       = "stopImmediatePropagation"
+	id_JS_EVENT_STOP_PROPAGATION                 = "stopPropagation"
+	id_JS_EVENT_TANGENTIAL_PRESSURE              = "tangentialPressure"
+	id_JS_EVENT_TARGET                           = "target"
+	id_JS_EVENT_TARGET_TOUCHES                   = "targetTouches"
+	id_JS_EVENT_TILT_X                           = "tiltX"
+	id_JS_EVENT_TILT_Y                           = "tiltY"
+	id_JS_EVENT_TIMESTAMP                        = "timeStamp"
+	id_JS_EVENT_TOUCHES                          = "touches"
+	id_JS_EVENT_TWIST                            = "twist"
+	id_JS_EVENT_TYPE                             = "type"
+	id_JS_EVENT_WIDTH                            = "width"
+	id_JS_FILES                                  = "files"
+	id_JS_FILE_LAST_MODIFIED                     = "lastModified"
+	id_JS_FILE_NAME                              = "name"
+	id_JS_FILE_SIZE                              = "size"
+	id_JS_FILE_TYPE                              = "type"
+	id_JS_FIRST_ELEMENT_CHILD                    = "firstElementChild"
+	id_JS_FLOAT32_ARRAY                          = "Float32Array"
+	id_JS_GET_ATTRIBUTE                          = "getAttribute"
+	id_JS_GET_COMPUTED_STYLE                     = "getComputedStyle"
+	id_JS_GET_ELEMENT_BY_ID                      = "getElementById"
+	id_JS_GET_PROPERTY_VALUE                     = "getPropertyValue"
+	id_JS_HAS_ATTRIBUTE                          = "hasAttribute"
+	id_JS_HTML                                   = "innerHTML"
+	id_JS_HTTP_BODY                              = "body"
+	id_JS_HTTP_CANCEL                            = "cancel"
+	id_JS_HTTP_CREDENTIALS                       = "credentials"
+	id_JS_HTTP_DONE                              = "done"
+	id_JS_HTTP_ENTRIES                           = "entries"
+	id_JS_HTTP_FETCH                             = "fetch"
+	id_JS_HTTP_GET_READER                        = "getReader"
+	id_JS_HTTP_HEADERS                           = "headers"
+	id_JS_HTTP_METHOD                            = "method"
+	id_JS_HTTP_MODE                              = "mode"
+	id_JS_HTTP_NEXT                              = "next"
+	id_JS_HTTP_READ                              = "read"
+	id_JS_HTTP_STATUS                            = "status"
+	id_JS_HTTP_STATUS_TEXT                       = "statusText"
+	id_JS_HTTP_URL                               = "url"
+	id_JS_HTTP_VALUE                             = "value"
+	id_JS_ID                                     = "id"
+	id_JS_IDB                                    = "indexedDB"
+	id_JS_IDB_ABORT                              = "abort"
+	id_JS_IDB_ADD                                = "add"
+	id_JS_IDB_AUTO_INCREMENT                     = "autoIncrement"
+	id_JS_IDB_BOUND                              = "bound"
+	id_JS_IDB_CLEAR                              = "clear"
+	id_JS_IDB_CLOSE                              = "close"
+	id_JS_IDB_COMMIT                             = "commit"
+	id_JS_IDB_CONTINUE                           = "continue"
+	id_JS_IDB_COUNT                              = "count"
+	id_JS_IDB_CREATE_INDEX                       = "createIndex"
+	id_JS_IDB_CREATE_OBJECT_STORE                = "createObjectStore"
+	id_JS_IDB_DELETE                             = "delete"
+	id_JS_IDB_DELETE_DATABASE                    = "deleteDatabase"
+	id_JS_IDB_DELETE_INDEX                       = "deleteIndex"
+	id_JS_IDB_DELETE_OBJECT_STORE                = "deleteObjectStore"
+	id_JS_IDB_ERROR                              = "error"
+	id_JS_IDB_GET                                = "get"
+	id_JS_IDB_GET_ALL                            = "getAll"
+	id_JS_IDB_INDEX                              = "index"
+	id_JS_IDB_KEY                                = "key"
+	id_JS_IDB_KEY_PATH                           = "keyPath"
+	id_JS_IDB_KEY_RANGE                          = "IDBKeyRange"
+	id_JS_IDB_LOWER_BOUND                        = "lowerBound"
+	id_JS_IDB_MULTI_ENTRY                        = "multiEntry"
+	id_JS_IDB_NAME                               = "name"
+	id_JS_IDB_NEW_VERSION                        = "newVersion"
+	id_JS_IDB_OBJECT_STORE                       = "objectStore"
+	id_JS_IDB_OLD_VERSION                        = "oldVersion"
+	id_JS_IDB_ONLY                               = "only"
+	id_JS_IDB_ON_ABORT                           = "onabort"
+	id_JS_IDB_ON_COMPLETE                        = "oncomplete"
+	id_JS_IDB_ON_ERROR                           = "onerror"
+	id_JS_IDB_ON_SUCCESS                         = "onsuccess"
+	id_JS_IDB_ON_UPGRADE_NEEDED                  = "onupgradeneeded"
+	id_JS_IDB_ON_VERSION_CHANGE                  = "onversionchange"
+	id_JS_IDB_OPEN                               = "open"
+	id_JS_IDB_OPEN_CURSOR                        = "openCursor"
+	id_JS_IDB_PRIMARY_KEY                        = "primaryKey"
+	id_JS_IDB_PUT                                = "put"
+	id_JS_IDB_READONLY                           = "readonly"
+	id_JS_IDB_READWRITE                          = "readwrite"
+	id_JS_IDB_RESULT                             = "result"
+	id_JS_IDB_TRANSACTION                        = "transaction"
+	id_JS_IDB_UNIQUE                             = "unique"
+	id_JS_IDB_UPDATE                             = "update"
+	id_JS_IDB_UPPER_BOUND                        = "upperBound"
+	id_JS_IDB_VALUE                              = "value"
+	id_JS_INPUT_EVENT                            = "InputEvent"
+	id_JS_INSERT_ADJACENT_HTML                   = "insertAdjacentHTML"
+	id_JS_INSERT_ADJACENT_TEXT                   = "insertAdjacentText"
+	id_JS_INSERT_BEFORE                          = "insertBefore"
+	id_JS_INT32_ARRAY                            = "Int32Array"
+	id_JS_INTERSECTION_OBSERVER                  = "IntersectionObserver"
+	id_JS_IS_ARRAY                               = "isArray"
+	id_JS_IS_VIEW                                = "isView"
+	id_JS_KEYBOARD_EVENT                         = "KeyboardEvent"
+	id_JS_KEYS                                   = "keys"
+	id_JS_LENGTH                                 = "length"
+	id_JS_MATCHES                                = "matches"
+	id_JS_MOUSE_EVENT                            = "MouseEvent"
+	id_JS_MUTATION_OBSERVER                      = "MutationObserver"
+	id_JS_NEXT_ELEMENT_SIBLING                   = "nextElementSibling"
+	id_JS_OBJECT                                 = "Object"
+	id_JS_OBSERVER_ADDED_NODES                   = "addedNodes"
+	id_JS_OBSERVER_ATTRIBUTES                    = "attributes"
+	id_JS_OBSERVER_ATTRIBUTE_FILTER              = "attributeFilter"
+	id_JS_OBSERVER_ATTRIBUTE_NAME                = "attributeName"
+	id_JS_OBSERVER_ATTRIBUTE_NAMESPACE           = "attributeNamespace"
+	id_JS_OBSERVER_ATTRIBUTE_OLD_VALUE           = "attributeOldValue"
+	id_JS_OBSERVER_BLOCK_SIZE                    = "blockSize"
+	id_JS_OBSERVER_BORDER_BOX_SIZE               = "borderBoxSize"
+	id_JS_OBSERVER_BOUNDING_CLIENT_RECT          = "boundingClientRect"
+	id_JS_OBSERVER_BOX                           = "box"
+	id_JS_OBSERVER_CHARACTER_DATA                = "characterData"
+	id_JS_OBSERVER_CHARACTER_DATA_OLD_VALUE      = "characterDataOldValue"
+	id_JS_OBSERVER_CHILD_LIST                    = "childList"
+	id_JS_OBSERVER_CONTENT_BOX_SIZE              = "contentBoxSize"
+	id_JS_OBSERVER_CONTENT_RECT                  = "contentRect"
+	id_JS_OBSERVER_DEVICE_PIXEL_CONTENT_BOX_SIZE = "devicePixelContentBoxSize"
+	id_JS_OBSERVER_DISCONNECT                    = "disconnect"
+	id_JS_OBSERVER_INLINE_SIZE                   = "inlineSize"
+	id_JS_OBSERVER_INTERSECTION_RATIO            = "intersectionRatio"
+	id_JS_OBSERVER_INTERSECTION_RECT             = "intersectionRect"
+	id_JS_OBSERVER_IS_INTERSECTING               = "isIntersecting"
+	id_JS_OBSERVER_NEXT_SIBLING                  = "nextSibling"
+	id_JS_OBSERVER_OBSERVE                       = "observe"
+	id_JS_OBSERVER_OLD_VALUE                     = "oldValue"
+	id_JS_OBSERVER_PREVIOUS_SIBLING              = "previousSibling"
+	id_JS_OBSERVER_REMOVED_NODES                 = "removedNodes"
+	id_JS_OBSERVER_ROOT                          = "root"
+	id_JS_OBSERVER_ROOT_BOUNDS                   = "rootBounds"
+	id_JS_OBSERVER_ROOT_MARGIN                   = "rootMargin"
+	id_JS_OBSERVER_SUBTREE                       = "subtree"
+	id_JS_OBSERVER_TAKE_RECORDS                  = "takeRecords"
+	id_JS_OBSERVER_TARGET                        = "target"
+	id_JS_OBSERVER_THRESHOLD                     = "threshold"
+	id_JS_OBSERVER_TIME                          = "time"
+	id_JS_OBSERVER_TYPE                          = "type"
+	id_JS_OBSERVER_UNOBSERVE                     = "unobserve"
+	id_JS_PARENT_NODE                            = "parentNode"
+	id_JS_POINTER_EVENT                          = "PointerEvent"
+	id_JS_PREPEND                                = "prepend"
+	id_JS_PROMISE                                = "Promise"
+	id_JS_QUERY_SELECTOR                         = "querySelector"
+	id_JS_QUERY_SELECTOR_ALL                     = "querySelectorAll"
+	id_JS_RECT_BOTTOM                            = "bottom"
+	id_JS_RECT_HEIGHT                            = "height"
+	id_JS_RECT_LEFT                              = "left"
+	id_JS_RECT_RIGHT                             = "right"
+	id_JS_RECT_TOP                               = "top"
+	id_JS_RECT_WIDTH                             = "width"
+	id_JS_RECT_X                                 = "x"
+	id_JS_RECT_Y                                 = "y"
+	id_JS_REFLECT                                = "Reflect"
+	id_JS_REFLECT_DELETE                         = "deleteProperty"
+	id_JS_REFLECT_SET                            = "set"
+	id_JS_REMOVE                                 = "remove"
+	id_JS_REMOVE_ATTRIBUTE                       = "removeAttribute"
+	id_JS_REMOVE_EVENT_LISTENER                  = "removeEventListener"
+	id_JS_REMOVE_PROPERTY                        = "removeProperty"
+	id_JS_REPLACE_WITH                           = "replaceWith"
+	id_JS_RESIZE_OBSERVER                        = "ResizeObserver"
+	id_JS_SET_ATTRIBUTE                          = "setAttribute"
+	id_JS_SET_PROPERTY                           = "setProperty"
+	id_JS_SHORTCUT_NAVIGATOR                     = "navigator"
+	id_JS_SHORTCUT_USER_AGENT                    = "userAgent"
+	id_JS_SIGNAL                                 = "signal"
+	id_JS_SLICE                                  = "slice"
+	id_JS_STORAGE_CLEAR                          = "clear"
+	id_JS_STORAGE_ERROR_NAME                     = "name"
+	id_JS_STORAGE_ERROR_QUOTA                    = "QuotaExceededError"
+	id_JS_STORAGE_ERROR_QUOTA_FIREFOX            = "NS_ERROR_DOM_QUOTA_REACHED"
+	id_JS_STORAGE_EVENT                          = "storage"
+	id_JS_STORAGE_GET_ITEM                       = "getItem"
+	id_JS_STORAGE_KEY                            = "key"
+	id_JS_STORAGE_LOCAL                          = "localStorage"
+	id_JS_STORAGE_NEW_VALUE                      = "newValue"
+	id_JS_STORAGE_OLD_VALUE                      = "oldValue"
+	id_JS_STORAGE_REMOVE_ITEM                    = "removeItem"
+	id_JS_STORAGE_SESSION                        = "sessionStorage"
+	id_JS_STORAGE_SET_ITEM                       = "setItem"
+	id_JS_STORAGE_STORAGE_AREA                   = "storageArea"
+	id_JS_STORAGE_URL                            = "url"
+	id_JS_STYLE                                  = "style"
+	id_JS_TAG_NAME                               = "tagName"
+	id_JS_TEXT_CONTENT                           = "textContent"
+	id_JS_THEN                                   = "then"
+	id_JS_TIMER_CANCEL_ANIMATION_FRAME           = "cancelAnimationFrame"
+	id_JS_TIMER_CLEAR_INTERVAL                   = "clearInterval"
+	id_JS_TIMER_CLEAR_TIMEOUT                    = "clearTimeout"
+	id_JS_TIMER_HIDDEN                           = "hidden"
+	id_JS_TIMER_REQUEST_ANIMATION_FRAME          = "requestAnimationFrame"
+	id_JS_TIMER_SET_INTERVAL                     = "setInterval"
+	id_JS_TIMER_SET_TIMEOUT                      = "setTimeout"
+	id_JS_TIMER_VISIBILITY_CHANGE                = "visibilitychange"
+	id_JS_TIMER_VISIBILITY_STATE                 = "visibilityState"
+	id_JS_TOGGLE                                 = "toggle"
+	id_JS_TOUCH_EVENT                            = "TouchEvent"
+	id_JS_TYPE                                   = "type"
+	id_JS_UINT8_ARRAY                            = "Uint8Array"
+	id_JS_WEBSOCKET                              = "WebSocket"
+	id_JS_WEBSOCKET_BINARY_TYPE                  = "binaryType"
+	id_JS_WEBSOCKET_CLOSE                        = "close"
+	id_JS_WEBSOCKET_CODE                         = "code"
+	id_JS_WEBSOCKET_DATA                         = "data"
+	id_JS_WEBSOCKET_ERROR                        = "error"
+	id_JS_WEBSOCKET_MESSAGE                      = "message"
+	id_JS_WEBSOCKET_OPEN                         = "open"
+	id_JS_WEBSOCKET_READY_STATE                  = "readyState"
+	id_JS_WEBSOCKET_REASON                       = "reason"
+	id_JS_WEBSOCKET_SEND                         = "send"
+	id_JS_WHEEL_EVENT                            = "WheelEvent"
 )
 
 const (
 	mime_CSS = "text/css"
 )
 
+const (
+	position_AFTER_BEGIN  = "afterbegin"
+	position_AFTER_END    = "afterend"
+	position_BEFORE_BEGIN = "beforebegin"
+	position_BEFORE_END   = "beforeend"
+)
+
 const (
 	tag_STYLE = "STYLE"
 )
 
+// funcRegistry keeps the live count of js.Func per FuncKind for diagnosis.
+var funcRegistry = struct {
+	count [func_KIND_MAX]uint
+	mutex sync.Mutex
+}{}
+
 // RETURN ERROR CODES
 //
 // HestiaWASM tries to standardizes its return error codes based on syscall/js
@@ -82,9 +363,29 @@ const (
 //   7. if output == unsupported { return hestiaError.EPROTONOSUPPORT }
 //   7. if output == ok { return hestiaError.OK }
 
+func _addClass(element *Object, name string) hestiaError.Error {
+	var list js.Value
+	var ok bool
+
+	if IsObjectOK(element) != hestiaError.OK {
+		return hestiaError.EOWNERDEAD
+	}
+
+	if name == "" {
+		return hestiaError.ENODATA
+	}
+
+	list = element.value.Get(id_JS_CLASS_LIST)
+	_, ok = __call(&list, id_JS_ADD, name)
+	if !ok {
+		return hestiaError.EILSEQ
+	}
+
+	return hestiaError.OK
+}
+
 func _addEventListener(element *Object, listener *EventListener) (err hestiaError.Error) {
-	var options map[string]any
-	var handler js.Func
+	var handle *EventHandle
 
 	if IsObjectOK(element) != hestiaError.OK {
 		return hestiaError.EOWNERDEAD
@@ -95,93 +396,203 @@ func _addEventListener(element *Object, listener *EventListener) (err hestiaErro
 	}
 
 	// check if listener is already attached
-	if listener.handler != nil {
+	if listener.handle != nil {
 		return hestiaError.EBADE
 	}
 
-	// create the Javascript compatible options list
-	options = map[string]any{
-		id_JS_EVENT_OPTION_CAPTURE: listener.Capture,
-		id_JS_EVENT_OPTION_ONCE:    listener.Once,
-		id_JS_EVENT_OPTION_PASSIVE: listener.Passive,
+	handle, err = __attachEvent(element, listener)
+	if err != hestiaError.OK {
+		return err
 	}
 
-	// create the Javascript compatible handler
-	handler = js.FuncOf(func(this js.Value, args []js.Value) any {
-		var obj js.Value
+	// save handle for later release
+	listener.handle = handle
 
-		// prevent default if set
-		if listener.PreventDefault {
-			args[0].Call(id_JS_EVENT_PREVENT_DEFAULT)
-		}
+	return hestiaError.OK
+}
 
-		// convert the event parameters into Go format
-		e := &Event{
-			IsBubble:         args[0].Get(id_JS_EVENT_BUBBLES).Bool(),
-			IsCancellable:    args[0].Get(id_JS_EVENT_CANCELABLE).Bool(),
-			IsComposed:       args[0].Get(id_JS_EVENT_COMPOSED).Bool(),
-			Phase:            EventPhase(args[0].Get(id_JS_EVENT_PHASE).Int()),
-			DefaultPrevented: args[0].Get(id_JS_EVENT_DEFAULT_PREVENTED).Bool(),
-			IsTrusted:        args[0].Get(id_JS_EVENT_IS_TRUSTED).Bool(),
-			Timestamp:        args[0].Get(id_JS_EVENT_TIMESTAMP).Float(),
-			Type:             args[0].Get(id_JS_EVENT_TYPE).String(),
-		}
+func _append(parent *Object, child *Object) hestiaError.Error {
+	if IsObjectOK(parent) != hestiaError.OK {
+		return hestiaError.EOWNERDEAD
+	}
 
-		if this.Equal(*(element.value)) {
-			e.This = element
-		} else {
-			e.This = &Object{value: &this}
-		}
+	if IsObjectOK(child) != hestiaError.OK {
+		return hestiaError.ENOENT
+	}
 
-		obj = args[0].Get(id_JS_EVENT_TARGET)
-		if obj.Equal(*(element.value)) {
-			e.Target = element
-		} else {
-			e.Target = &Object{value: &obj}
-		}
+	parent.value.Call(id_JS_APPEND, *(child.value))
+
+	return hestiaError.OK
+}
+
+func _attachEventListener(element *Object, listener *EventListener) (*EventHandle, hestiaError.Error) {
+	if IsObjectOK(element) != hestiaError.OK {
+		return nil, hestiaError.EOWNERDEAD
+	}
+
+	if IsEventListenerOK(listener) != hestiaError.OK {
+		return nil, hestiaError.ENOMEDIUM
+	}
+
+	return __attachEvent(element, listener)
+}
+
+func _await(promise *Object, timeout time.Duration) (out any, err hestiaError.Error) {
+	var ret *Object
+	var cErr hestiaError.Error
+
+	ret, err = AwaitObject(promise, timeout)
+	if ret == nil {
+		return nil, err
+	}
+
+	out, cErr = Convert(ret, 0)
 
-		obj = args[0].Get(id_JS_EVENT_CURRENT_TARGET)
-		if obj.Equal(*(element.value)) {
-			e.CurrentTarget = element
-		} else {
-			e.CurrentTarget = &Object{value: &obj}
+	// a rejected Promise keeps its error even if its reason is unconvertable
+	if err != hestiaError.OK {
+		if cErr != hestiaError.OK {
+			out = nil
 		}
 
-		// execute the listener function
-		go listener.Function(e)
+		return out, err
+	}
 
-		// return nothing since this is a JS function wrapper.
-		return nil
-	})
+	if cErr != hestiaError.OK {
+		return nil, cErr
+	}
 
-	// call the JS.addEventListener API
-	element.value.Call(id_JS_ADD_EVENT_LISTENER,
-		listener.Name,
-		handler,
-		options,
-	)
+	return out, hestiaError.OK
+}
 
-	// save function for later release
-	listener.handler = &Object{
-		value:    element.value,
-		function: &handler,
+func _awaitObject(promise *Object, timeout time.Duration) (*Object, hestiaError.Error) {
+	var ret js.Value
+	var err hestiaError.Error
+
+	if IsObjectOK(promise) != hestiaError.OK {
+		return nil, hestiaError.EOWNERDEAD
 	}
 
-	return hestiaError.OK
+	ret, err = __await(*(promise.value), timeout)
+	if err == hestiaError.ETIMEDOUT {
+		return nil, err
+	}
+
+	return &Object{
+		value: &ret,
+	}, err
 }
 
-func _append(parent *Object, child *Object) hestiaError.Error {
+func _call(element *Object, method string, args []any) (*Object, hestiaError.Error) {
+	var ret js.Value
+	var list []any
+	var ok bool
+
+	if IsObjectOK(element) != hestiaError.OK {
+		return nil, hestiaError.EOWNERDEAD
+	}
+
+	if method == "" {
+		return nil, hestiaError.ENODATA
+	}
+
+	list, ok = __toJSArgs(args)
+	if !ok {
+		return nil, hestiaError.EINVAL
+	}
+
+	if element.value.Get(method).Type() != js.TypeFunction {
+		return nil, hestiaError.EPROTOTYPE
+	}
+
+	ret, ok = __call(element.value, method, list...)
+	if !ok {
+		return nil, hestiaError.EPROTO
+	}
+
+	return &Object{
+		value: &ret,
+	}, hestiaError.OK
+}
+
+func _children(element *Object) ([]*Object, hestiaError.Error) {
+	if IsObjectOK(element) != hestiaError.OK {
+		return nil, hestiaError.EOWNERDEAD
+	}
+
+	return __toObjectList(element.value.Get(id_JS_CHILDREN)), hestiaError.OK
+}
+
+func _closest(element *Object, selector string) (*Object, hestiaError.Error) {
+	var ret js.Value
+	var ok bool
+
+	if IsObjectOK(element) != hestiaError.OK {
+		return nil, hestiaError.EOWNERDEAD
+	}
+
+	if selector == "" {
+		return nil, hestiaError.ENODATA
+	}
+
+	ret, ok = __call(element.value, id_JS_CLOSEST, selector)
+	if !ok {
+		return nil, hestiaError.EILSEQ
+	}
+
+	return __toObject(ret)
+}
+
+func _cloneNode(element *Object, deep bool) (*Object, hestiaError.Error) {
+	if IsObjectOK(element) != hestiaError.OK {
+		return nil, hestiaError.EOWNERDEAD
+	}
+
+	ret := element.value.Call(id_JS_CLONE_NODE, deep)
+
+	return &Object{
+		value: &ret,
+	}, hestiaError.OK
+}
+
+func _contains(parent *Object, child *Object) (bool, hestiaError.Error) {
 	if IsObjectOK(parent) != hestiaError.OK {
-		return hestiaError.EOWNERDEAD
+		return false, hestiaError.EOWNERDEAD
 	}
 
 	if IsObjectOK(child) != hestiaError.OK {
-		return hestiaError.ENOENT
+		return false, hestiaError.ENOENT
 	}
 
-	parent.value.Call(id_JS_APPEND, *(child.value))
+	return parent.value.Call(id_JS_CONTAINS, *(child.value)).Bool(),
+		hestiaError.OK
+}
 
-	return hestiaError.OK
+func _convert(element *Object, depth uint) (any, hestiaError.Error) {
+	if IsObjectOK(element) != hestiaError.OK {
+		return nil, hestiaError.EOWNERDEAD
+	}
+
+	if depth == 0 {
+		depth = convert_DEPTH_DEFAULT
+	}
+
+	return __convertValue(*(element.value), depth, nil)
+}
+
+func _countFuncs(kind FuncKind) (count uint, err hestiaError.Error) {
+	var i FuncKind
+
+	funcRegistry.mutex.Lock()
+	if kind == FUNC_KIND_ALL {
+		for i = 0; i < func_KIND_MAX; i++ {
+			count += funcRegistry.count[i]
+		}
+	} else {
+		count = funcRegistry.count[kind]
+	}
+	funcRegistry.mutex.Unlock()
+
+	return count, hestiaError.OK
 }
 
 func _createElement(name string) (child *Object, err hestiaError.Error) {
@@ -198,12 +609,110 @@ func _createElement(name string) (child *Object, err hestiaError.Error) {
 	}, hestiaError.OK
 }
 
+func _decode(element *Object, out any, depth uint) (err hestiaError.Error) {
+	var target reflect.Value
+	var data any
+
+	if IsObjectOK(element) != hestiaError.OK {
+		return hestiaError.EOWNERDEAD
+	}
+
+	if out == nil {
+		return hestiaError.EINVAL
+	}
+
+	target = reflect.ValueOf(out)
+	if target.Kind() != reflect.Pointer || target.IsNil() {
+		return hestiaError.EINVAL
+	}
+
+	data, err = Convert(element, depth)
+	if err != hestiaError.OK {
+		return err
+	}
+
+	return __decodeValue(target.Elem(), data)
+}
+
+func _delete(element *Object, key string) hestiaError.Error {
+	var ret js.Value
+
+	if IsObjectOK(element) != hestiaError.OK {
+		return hestiaError.EOWNERDEAD
+	}
+
+	if key == "" {
+		return hestiaError.ENODATA
+	}
+
+	// use Reflect to obtain the verdict instead of silently failing.
+	ret = Global().value.Get(id_JS_REFLECT).Call(id_JS_REFLECT_DELETE,
+		*(element.value),
+		key,
+	)
+	if !ret.Truthy() {
+		return hestiaError.EPERM
+	}
+
+	return hestiaError.OK
+}
+
+func _detachEventListener(handle *EventHandle) hestiaError.Error {
+	if !__detachEvent(handle) {
+		return hestiaError.EBADE
+	}
+
+	return hestiaError.OK
+}
+
+func _dispatchCustomEvent(target *Object,
+	name string,
+	detail any,
+	options *EventOptions) (bool, hestiaError.Error) {
+	var payload any
+	var err hestiaError.Error
+
+	payload, err = __encodeValue(reflect.ValueOf(detail), convert_DEPTH_DEFAULT)
+	if err != hestiaError.OK {
+		return false, err
+	}
+
+	return __dispatch(target, id_JS_CUSTOM_EVENT, name, payload, options)
+}
+
+func _dispatchEvent(target *Object,
+	name string,
+	options *EventOptions) (bool, hestiaError.Error) {
+	return __dispatch(target, id_JS_EVENT, name, nil, options)
+}
+
+func _encodeNative(value reflect.Value) (any, bool) {
+	var obj *Object
+
+	if !value.CanInterface() {
+		return nil, false
+	}
+
+	switch v := value.Interface().(type) {
+	case js.Value:
+		return v, true
+	case *Object:
+		if obj = v; obj == nil || obj.value == nil {
+			return nil, true
+		}
+
+		return *(obj.value), true
+	}
+
+	return nil, false
+}
+
 func _execJSFunc(withRet bool, name string, args []any) (out any, err hestiaError.Error) {
 	var ret js.Value
 	var global *Object
 
 	// validate all args are convertable to prevent possible panics
-	for arg := range args {
+	for _, arg := range args {
 		if IsTypeConvertable(arg) != hestiaError.OK {
 			return nil, hestiaError.EINVAL
 		}
@@ -225,29 +734,20 @@ func _execJSFunc(withRet bool, name string, args []any) (out any, err hestiaErro
 	}
 
 	// Convert return value to compatible Go format
-	switch ret.Type() {
-	case js.TypeBoolean:
-		out = ret.Bool()
-	case js.TypeNumber:
-		out = ret.Float()
-	case js.TypeNull:
-		out = nil
-	case js.TypeUndefined:
-		out = nil
-	case js.TypeObject:
-		out = "<Javascript Object>"
-	case js.TypeFunction:
-		out = "<Javascript Function>"
-	case js.TypeString:
-		fallthrough
-	default:
-		out = ret.String()
-	}
+	out, err = Convert(&Object{value: &ret}, 0)
 
 done:
 	return out, err
 }
 
+func _firstChild(element *Object) (*Object, hestiaError.Error) {
+	if IsObjectOK(element) != hestiaError.OK {
+		return nil, hestiaError.EOWNERDEAD
+	}
+
+	return __toObject(element.value.Get(id_JS_FIRST_ELEMENT_CHILD))
+}
+
 func _get(parent *Object, query string) *Object {
 	if query == "" {
 		return nil
@@ -264,166 +764,1507 @@ func _get(parent *Object, query string) *Object {
 	}
 }
 
-func _getElementByID(id string) *Object {
+func _getAttribute(element *Object, name string) (string, hestiaError.Error) {
 	var ret js.Value
 
-	if id == "" {
-		return nil
+	if IsObjectOK(element) != hestiaError.OK {
+		return "", hestiaError.EOWNERDEAD
 	}
 
-	ret = Document().value.Call(id_JS_GET_ELEMENT_BY_ID, id)
-	if ret.IsNull() || ret.IsNaN() || ret.IsUndefined() {
-		return nil
+	if name == "" {
+		return "", hestiaError.ENODATA
 	}
 
-	return &Object{
-		value: &ret,
+	ret = element.value.Call(id_JS_GET_ATTRIBUTE, name)
+	if ret.IsNull() || ret.IsUndefined() {
+		return "", hestiaError.ENOPROTOOPT
 	}
+
+	return ret.String(), hestiaError.OK
 }
 
-func _removeEventListener(element *Object, listener *EventListener) hestiaError.Error {
-	var options map[string]any
+func _getBool(element *Object, key string) (bool, hestiaError.Error) {
+	var ret js.Value
+	var err hestiaError.Error
 
-	if IsObjectOK(element) != hestiaError.OK {
-		return hestiaError.EOWNERDEAD
+	ret, err = __getTyped(element, key, js.TypeBoolean)
+	if err != hestiaError.OK {
+		return false, err
 	}
 
-	if IsEventListenerOK(listener) != hestiaError.OK {
-		return hestiaError.ENOMEDIUM
-	}
+	return ret.Bool(), hestiaError.OK
+}
 
-	// check if listener is already free
-	if listener.handler == nil {
-		return hestiaError.EBADE
-	}
+func _getComputedCSS(element *Object, property string) (string, hestiaError.Error) {
+	var style js.Value
+	var value string
 
-	if listener.handler.function == nil {
-		return hestiaError.EBADE
+	if IsObjectOK(element) != hestiaError.OK {
+		return "", hestiaError.EOWNERDEAD
 	}
 
-	// create the Javascript compatible options list
-	options = map[string]any{
-		id_JS_EVENT_OPTION_CAPTURE: listener.Capture,
+	if property == "" {
+		return "", hestiaError.ENOTNAM
 	}
 
-	// call the JS.removeEventListener API
-	element.value.Call(id_JS_REMOVE_EVENT_LISTENER,
-		listener.Name,
-		*(listener.handler.function),
-		options,
+	style = Global().value.Call(id_JS_GET_COMPUTED_STYLE, *(element.value))
+	value = strings.TrimSpace(
+		style.Call(id_JS_GET_PROPERTY_VALUE, property).String(),
 	)
+	if value == "" {
+		return "", hestiaError.ENOPROTOOPT
+	}
 
-	// release save function
-	listener.handler = nil
-
-	return hestiaError.OK
+	return value, hestiaError.OK
 }
 
-func _setHTML(element *Object, html *[]byte) hestiaError.Error {
-	if html == nil {
-		return hestiaError.ENODATA
-	}
+func _getDataset(element *Object, key string) (string, hestiaError.Error) {
+	var ret js.Value
 
 	if IsObjectOK(element) != hestiaError.OK {
-		return hestiaError.EOWNERDEAD
+		return "", hestiaError.EOWNERDEAD
 	}
 
-	element.value.Set(id_JS_HTML, string(*html))
+	if key == "" {
+		return "", hestiaError.ENODATA
+	}
 
-	return hestiaError.OK
+	ret = element.value.Get(id_JS_DATASET).Get(key)
+	if ret.IsNull() || ret.IsUndefined() {
+		return "", hestiaError.ENOPROTOOPT
+	}
+
+	return ret.String(), hestiaError.OK
 }
 
-func _setStylesheet(id string, value string) (err hestiaError.Error) {
-	var element *Object
+func _getElementByID(id string) *Object {
+	var ret js.Value
 
 	if id == "" {
-		return hestiaError.ENOTNAM
+		return nil
 	}
 
-	if value == "" {
+	ret = Document().value.Call(id_JS_GET_ELEMENT_BY_ID, id)
+	if ret.IsNull() || ret.IsNaN() || ret.IsUndefined() {
+		return nil
+	}
+
+	return &Object{
+		value: &ret,
+	}
+}
+
+func _getFloat(element *Object, key string) (float64, hestiaError.Error) {
+	var ret js.Value
+	var err hestiaError.Error
+
+	ret, err = __getTyped(element, key, js.TypeNumber)
+	if err != hestiaError.OK {
+		return 0, err
+	}
+
+	return ret.Float(), hestiaError.OK
+}
+
+func _getHTML(element *Object) ([]byte, hestiaError.Error) {
+	if IsObjectOK(element) != hestiaError.OK {
+		return nil, hestiaError.EOWNERDEAD
+	}
+
+	return []byte(element.value.Get(id_JS_HTML).String()), hestiaError.OK
+}
+
+func _getInt(element *Object, key string) (int, hestiaError.Error) {
+	var ret js.Value
+	var err hestiaError.Error
+	var number float64
+
+	ret, err = __getTyped(element, key, js.TypeNumber)
+	if err != hestiaError.OK {
+		return 0, err
+	}
+
+	number = ret.Float()
+	if number != float64(int(number)) {
+		return 0, hestiaError.ERANGE
+	}
+
+	return int(number), hestiaError.OK
+}
+
+func _getString(element *Object, key string) (string, hestiaError.Error) {
+	var ret js.Value
+	var err hestiaError.Error
+
+	ret, err = __getTyped(element, key, js.TypeString)
+	if err != hestiaError.OK {
+		return "", err
+	}
+
+	return ret.String(), hestiaError.OK
+}
+
+func _getText(element *Object) (string, hestiaError.Error) {
+	var ret js.Value
+
+	if IsObjectOK(element) != hestiaError.OK {
+		return "", hestiaError.EOWNERDEAD
+	}
+
+	ret = element.value.Get(id_JS_TEXT_CONTENT)
+	if ret.IsNull() || ret.IsUndefined() {
+		return "", hestiaError.OK
+	}
+
+	return ret.String(), hestiaError.OK
+}
+
+func _hasAttribute(element *Object, name string) (bool, hestiaError.Error) {
+	if IsObjectOK(element) != hestiaError.OK {
+		return false, hestiaError.EOWNERDEAD
+	}
+
+	if name == "" {
+		return false, hestiaError.ENODATA
+	}
+
+	return element.value.Call(id_JS_HAS_ATTRIBUTE, name).Bool(), hestiaError.OK
+}
+
+func _hasClass(element *Object, name string) (bool, hestiaError.Error) {
+	if IsObjectOK(element) != hestiaError.OK {
+		return false, hestiaError.EOWNERDEAD
+	}
+
+	if name == "" {
+		return false, hestiaError.ENODATA
+	}
+
+	return element.value.Get(id_JS_CLASS_LIST).Call(id_JS_CONTAINS, name).Bool(),
+		hestiaError.OK
+}
+
+func _insertAdjacentHTML(element *Object, position HTMLPosition, html *[]byte) hestiaError.Error {
+	if html == nil {
 		return hestiaError.ENODATA
 	}
 
-	// attempting to get style element using name
-	element = GetElementByID(id)
-	switch {
-	case element == nil:
-	case element.value.Get(id_JS_TAG_NAME).String() == tag_STYLE:
-	default:
-		element = nil
+	return __insertAdjacent(element,
+		id_JS_INSERT_ADJACENT_HTML,
+		position,
+		string(*html),
+	)
+}
+
+func _insertAdjacentText(element *Object, position HTMLPosition, text string) hestiaError.Error {
+	return __insertAdjacent(element,
+		id_JS_INSERT_ADJACENT_TEXT,
+		position,
+		text,
+	)
+}
+
+func _insertBefore(parent *Object, child *Object, reference *Object) hestiaError.Error {
+	var ref js.Value
+	var ok bool
+
+	if IsObjectOK(parent) != hestiaError.OK {
+		return hestiaError.EOWNERDEAD
 	}
 
-	// create element if missing
-	if element == nil {
-		element, _ = CreateElement(tag_STYLE)
-		Append(Head(), element)
+	if IsObjectOK(child) != hestiaError.OK {
+		return hestiaError.ENOENT
 	}
 
-	// set CSS contents
-	element.value.Set(id_JS_TYPE, mime_CSS)
-	element.value.Set(id_JS_ID, id)
-	element.value.Set(id_JS_HTML, value)
+	ref = js.Null()
+	if reference != nil {
+		if IsObjectOK(reference) != hestiaError.OK {
+			return hestiaError.EINVAL
+		}
+
+		ref = *(reference.value)
+	}
+
+	_, ok = __call(parent.value, id_JS_INSERT_BEFORE, *(child.value), ref)
+	if !ok {
+		return hestiaError.EINVAL
+	}
 
 	return hestiaError.OK
 }
 
-// NOTE: all functions below are sub-functions. Please use the global version
-// since it has proper guarding like `nil` object checking.
+func _isNull(element *Object) (bool, hestiaError.Error) {
+	if IsObjectOK(element) != hestiaError.OK {
+		return false, hestiaError.EOWNERDEAD
+	}
 
-func _goPromise(promise *Promise) (err hestiaError.Error) {
-	var jsFunc js.Func
+	return element.value.IsNull(), hestiaError.OK
+}
 
-	// obtain promise object from global
-	if promise.object == nil {
-		promise.object = Get(Global(), id_JS_PROMISE)
+func _isUndefined(element *Object) (bool, hestiaError.Error) {
+	if IsObjectOK(element) != hestiaError.OK {
+		return false, hestiaError.EOWNERDEAD
 	}
 
-	// generate promise function
-	jsFunc = js.FuncOf(func(this js.Value, args []js.Value) any {
-		handler := __newGenericJSPromiseHandler(promise)
-		return promise.object.value.New(*handler)
-	})
-	promise.object.function = &jsFunc
+	return element.value.IsUndefined(), hestiaError.OK
+}
 
-	// expose Promise function to Javascript
-	Global().value.Set(promise.Name, *(promise.object.function))
+func _matches(element *Object, selector string) (bool, hestiaError.Error) {
+	var ret js.Value
+	var ok bool
 
-	return hestiaError.OK
+	if IsObjectOK(element) != hestiaError.OK {
+		return false, hestiaError.EOWNERDEAD
+	}
+
+	if selector == "" {
+		return false, hestiaError.ENODATA
+	}
+
+	ret, ok = __call(element.value, id_JS_MATCHES, selector)
+	if !ok {
+		return false, hestiaError.EILSEQ
+	}
+
+	return ret.Truthy(), hestiaError.OK
 }
 
-func __newGenericJSPromiseHandler(promise *Promise) *js.Func {
-	var handler js.Func
+func _new(constructor *Object, args []any) (out *Object, err hestiaError.Error) {
+	var ret js.Value
+	var list []any
+	var ok bool
 
-	handler = js.FuncOf(func(this js.Value, args []js.Value) any {
-		switch {
-		case len(args) < 2:
-			promise.Reject(hestiaError.ENOTRECOVERABLE)
-			return nil
-		case args[0].Type() != js.TypeFunction,
-			args[1].Type() != js.TypeFunction:
-			promise.Reject(hestiaError.ENOTRECOVERABLE)
-			return nil
-		default:
+	if IsObjectOK(constructor) != hestiaError.OK {
+		return nil, hestiaError.EOWNERDEAD
+	}
+
+	list, ok = __toJSArgs(args)
+	if !ok {
+		return nil, hestiaError.EINVAL
+	}
+
+	if constructor.value.Type() != js.TypeFunction {
+		return nil, hestiaError.EPROTOTYPE
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			out = nil
+			err = hestiaError.EPROTO
 		}
+	}()
 
-		go func() {
-			ret := promise.Func()
+	ret = constructor.value.New(list...)
 
-			if ret == hestiaError.OK {
-				// resolve
-				args[0].Invoke(js.ValueOf(promise.Resolve()))
-			} else {
-				//reject
-				args[1].Invoke(js.ValueOf(promise.Reject(hestiaError.OK)))
-			}
+	return &Object{
+		value: &ret,
+	}, hestiaError.OK
+}
 
-			handler.Release()
-		}()
+func _nextSibling(element *Object) (*Object, hestiaError.Error) {
+	if IsObjectOK(element) != hestiaError.OK {
+		return nil, hestiaError.EOWNERDEAD
+	}
 
-		return nil
-	})
+	return __toObject(element.value.Get(id_JS_NEXT_ELEMENT_SIBLING))
+}
 
-	return &handler
+func _parent(element *Object) (*Object, hestiaError.Error) {
+	if IsObjectOK(element) != hestiaError.OK {
+		return nil, hestiaError.EOWNERDEAD
+	}
+
+	return __toObject(element.value.Get(id_JS_PARENT_NODE))
+}
+
+func _prepend(parent *Object, child *Object) hestiaError.Error {
+	var ok bool
+
+	if IsObjectOK(parent) != hestiaError.OK {
+		return hestiaError.EOWNERDEAD
+	}
+
+	if IsObjectOK(child) != hestiaError.OK {
+		return hestiaError.ENOENT
+	}
+
+	_, ok = __call(parent.value, id_JS_PREPEND, *(child.value))
+	if !ok {
+		return hestiaError.EINVAL
+	}
+
+	return hestiaError.OK
+}
+
+func _querySelector(root *Object, selector string) (*Object, hestiaError.Error) {
+	var ret js.Value
+	var ok bool
+
+	if IsObjectOK(root) != hestiaError.OK {
+		return nil, hestiaError.EOWNERDEAD
+	}
+
+	if selector == "" {
+		return nil, hestiaError.ENODATA
+	}
+
+	ret, ok = __call(root.value, id_JS_QUERY_SELECTOR, selector)
+	if !ok {
+		return nil, hestiaError.EILSEQ
+	}
+
+	return __toObject(ret)
+}
+
+func _querySelectorAll(root *Object, selector string) ([]*Object, hestiaError.Error) {
+	var ret js.Value
+	var ok bool
+
+	if IsObjectOK(root) != hestiaError.OK {
+		return nil, hestiaError.EOWNERDEAD
+	}
+
+	if selector == "" {
+		return nil, hestiaError.ENODATA
+	}
+
+	ret, ok = __call(root.value, id_JS_QUERY_SELECTOR_ALL, selector)
+	if !ok {
+		return nil, hestiaError.EILSEQ
+	}
+
+	return __toObjectList(ret), hestiaError.OK
+}
+
+func _remove(element *Object) hestiaError.Error {
+	if IsObjectOK(element) != hestiaError.OK {
+		return hestiaError.EOWNERDEAD
+	}
+
+	element.value.Call(id_JS_REMOVE)
+
+	return hestiaError.OK
+}
+
+func _removeAttribute(element *Object, name string) hestiaError.Error {
+	if IsObjectOK(element) != hestiaError.OK {
+		return hestiaError.EOWNERDEAD
+	}
+
+	if name == "" {
+		return hestiaError.ENODATA
+	}
+
+	element.value.Call(id_JS_REMOVE_ATTRIBUTE, name)
+
+	return hestiaError.OK
+}
+
+func _removeClass(element *Object, name string) hestiaError.Error {
+	var list js.Value
+	var ok bool
+
+	if IsObjectOK(element) != hestiaError.OK {
+		return hestiaError.EOWNERDEAD
+	}
+
+	if name == "" {
+		return hestiaError.ENODATA
+	}
+
+	list = element.value.Get(id_JS_CLASS_LIST)
+	_, ok = __call(&list, id_JS_REMOVE, name)
+	if !ok {
+		return hestiaError.EILSEQ
+	}
+
+	return hestiaError.OK
+}
+
+func _removeCSS(element *Object, property string) hestiaError.Error {
+	if IsObjectOK(element) != hestiaError.OK {
+		return hestiaError.EOWNERDEAD
+	}
+
+	if property == "" {
+		return hestiaError.ENOTNAM
+	}
+
+	element.value.Get(id_JS_STYLE).Call(id_JS_REMOVE_PROPERTY, property)
+
+	return hestiaError.OK
+}
+
+func _removeEventListener(element *Object, listener *EventListener) hestiaError.Error {
+	if IsObjectOK(element) != hestiaError.OK {
+		return hestiaError.EOWNERDEAD
+	}
+
+	if IsEventListenerOK(listener) != hestiaError.OK {
+		return hestiaError.ENOMEDIUM
+	}
+
+	// check if listener is already free
+	if listener.handle == nil {
+		return hestiaError.EBADE
+	}
+
+	if !__detachEvent(listener.handle) {
+		listener.handle = nil
+		return hestiaError.EBADE
+	}
+
+	// release saved handle and chain bridge
+	listener.handle = nil
+	listener.bridge = nil
+
+	return hestiaError.OK
+}
+
+func _replaceWith(element *Object, replacement *Object) hestiaError.Error {
+	var ok bool
+
+	if IsObjectOK(element) != hestiaError.OK {
+		return hestiaError.EOWNERDEAD
+	}
+
+	if IsObjectOK(replacement) != hestiaError.OK {
+		return hestiaError.ENOENT
+	}
+
+	_, ok = __call(element.value, id_JS_REPLACE_WITH, *(replacement.value))
+	if !ok {
+		return hestiaError.EINVAL
+	}
+
+	return hestiaError.OK
+}
+
+func _set(element *Object, key string, value any) hestiaError.Error {
+	var ret js.Value
+	var list []any
+	var ok bool
+
+	if IsObjectOK(element) != hestiaError.OK {
+		return hestiaError.EOWNERDEAD
+	}
+
+	if key == "" {
+		return hestiaError.ENODATA
+	}
+
+	list, ok = __toJSArgs([]any{value})
+	if !ok {
+		return hestiaError.EINVAL
+	}
+
+	// use Reflect to obtain the verdict instead of silently failing.
+	ret = Global().value.Get(id_JS_REFLECT).Call(id_JS_REFLECT_SET,
+		*(element.value),
+		key,
+		list[0],
+	)
+	if !ret.Truthy() {
+		return hestiaError.EPERM
+	}
+
+	return hestiaError.OK
+}
+
+func _setAttribute(element *Object, name string, value string) hestiaError.Error {
+	var ok bool
+
+	if IsObjectOK(element) != hestiaError.OK {
+		return hestiaError.EOWNERDEAD
+	}
+
+	if name == "" {
+		return hestiaError.ENODATA
+	}
+
+	_, ok = __call(element.value, id_JS_SET_ATTRIBUTE, name, value)
+	if !ok {
+		return hestiaError.EILSEQ
+	}
+
+	return hestiaError.OK
+}
+
+func _setCSS(element *Object, property string, value string) hestiaError.Error {
+	var style js.Value
+
+	if IsObjectOK(element) != hestiaError.OK {
+		return hestiaError.EOWNERDEAD
+	}
+
+	if property == "" {
+		return hestiaError.ENOTNAM
+	}
+
+	if value == "" {
+		return hestiaError.ENODATA
+	}
+
+	style = element.value.Get(id_JS_STYLE)
+	style.Call(id_JS_SET_PROPERTY, property, value)
+
+	// browser silently drops invalid values so read it back for a verdict.
+	if style.Call(id_JS_GET_PROPERTY_VALUE, property).String() == "" {
+		return hestiaError.EINVAL
+	}
+
+	return hestiaError.OK
+}
+
+func _setCSSVariables(element *Object, list *hestiaUI.CSSVarList) (err hestiaError.Error) {
+	var v *hestiaUI.CSSVariable
+
+	if IsObjectOK(element) != hestiaError.OK {
+		return hestiaError.EOWNERDEAD
+	}
+
+	if list == nil {
+		return hestiaError.ENODATA
+	}
+
+	for _, v = range *list {
+		if v == nil {
+			continue
+		}
+
+		err = SetCSS(element, v.Key, v.Value)
+		if err != hestiaError.OK {
+			return err
+		}
+	}
+
+	return hestiaError.OK
+}
+
+func _setDataset(element *Object, key string, value string) (err hestiaError.Error) {
+	if IsObjectOK(element) != hestiaError.OK {
+		return hestiaError.EOWNERDEAD
+	}
+
+	if key == "" {
+		return hestiaError.ENODATA
+	}
+
+	// invalid dataset keys (e.g. "-a") raise a DOMException: SyntaxError.
+	defer func() {
+		if r := recover(); r != nil {
+			err = hestiaError.EILSEQ
+		}
+	}()
+
+	element.value.Get(id_JS_DATASET).Set(key, value)
+
+	return hestiaError.OK
+}
+
+func _setHTML(element *Object, html *[]byte) hestiaError.Error {
+	if html == nil {
+		return hestiaError.ENODATA
+	}
+
+	if IsObjectOK(element) != hestiaError.OK {
+		return hestiaError.EOWNERDEAD
+	}
+
+	element.value.Set(id_JS_HTML, string(*html))
+
+	return hestiaError.OK
+}
+
+func _setStylesheet(id string, value string) (err hestiaError.Error) {
+	var element *Object
+
+	if id == "" {
+		return hestiaError.ENOTNAM
+	}
+
+	if value == "" {
+		return hestiaError.ENODATA
+	}
+
+	// attempting to get style element using name
+	element = GetElementByID(id)
+	switch {
+	case element == nil:
+	case element.value.Get(id_JS_TAG_NAME).String() == tag_STYLE:
+	default:
+		element = nil
+	}
+
+	// create element if missing
+	if element == nil {
+		element, _ = CreateElement(tag_STYLE)
+		Append(Head(), element)
+	}
+
+	// set CSS contents
+	element.value.Set(id_JS_TYPE, mime_CSS)
+	element.value.Set(id_JS_ID, id)
+	element.value.Set(id_JS_HTML, value)
+
+	return hestiaError.OK
+}
+
+func _setText(element *Object, text string) hestiaError.Error {
+	if IsObjectOK(element) != hestiaError.OK {
+		return hestiaError.EOWNERDEAD
+	}
+
+	element.value.Set(id_JS_TEXT_CONTENT, text)
+
+	return hestiaError.OK
+}
+
+func _toggleClass(element *Object, name string) (bool, hestiaError.Error) {
+	var list, ret js.Value
+	var ok bool
+
+	if IsObjectOK(element) != hestiaError.OK {
+		return false, hestiaError.EOWNERDEAD
+	}
+
+	if name == "" {
+		return false, hestiaError.ENODATA
+	}
+
+	list = element.value.Get(id_JS_CLASS_LIST)
+	ret, ok = __call(&list, id_JS_TOGGLE, name)
+	if !ok {
+		return false, hestiaError.EILSEQ
+	}
+
+	return ret.Bool(), hestiaError.OK
+}
+
+func _typeOf(element *Object) (ValueType, hestiaError.Error) {
+	if IsObjectOK(element) != hestiaError.OK {
+		return VALUE_TYPE_UNKNOWN, hestiaError.EOWNERDEAD
+	}
+
+	switch element.value.Type() {
+	case js.TypeUndefined:
+		return VALUE_TYPE_UNDEFINED, hestiaError.OK
+	case js.TypeNull:
+		return VALUE_TYPE_NULL, hestiaError.OK
+	case js.TypeBoolean:
+		return VALUE_TYPE_BOOLEAN, hestiaError.OK
+	case js.TypeNumber:
+		return VALUE_TYPE_NUMBER, hestiaError.OK
+	case js.TypeString:
+		return VALUE_TYPE_STRING, hestiaError.OK
+	case js.TypeSymbol:
+		return VALUE_TYPE_SYMBOL, hestiaError.OK
+	case js.TypeObject:
+		return VALUE_TYPE_OBJECT, hestiaError.OK
+	case js.TypeFunction:
+		return VALUE_TYPE_FUNCTION, hestiaError.OK
+	default:
+		return VALUE_TYPE_UNKNOWN, hestiaError.OK
+	}
+}
+
+func _unregisterPromise(promise *Promise) hestiaError.Error {
+	// check if promise is registered
+	if promise.object == nil || promise.object.function == nil {
+		return hestiaError.EBADE
+	}
+
+	// remove Promise function from Javascript
+	Global().value.Delete(promise.Name)
+
+	// release saved function
+	__release(FUNC_KIND_PROMISE, promise.object.function)
+	promise.object.function = nil
+
+	return hestiaError.OK
+}
+
+// NOTE: all functions below are sub-functions. Please use the global version
+// since it has proper guarding like `nil` object checking.
+
+func _goPromise(promise *Promise) (err hestiaError.Error) {
+	var jsFunc js.Func
+
+	// obtain promise object from global
+	if promise.object == nil {
+		promise.object = Get(Global(), id_JS_PROMISE)
+	}
+
+	// check if promise is already registered
+	if promise.object.function != nil {
+		return hestiaError.EBADE
+	}
+
+	// generate promise function
+	jsFunc = __funcOf(FUNC_KIND_PROMISE, func(this js.Value, args []js.Value) any {
+		handler := __newGenericJSPromiseHandler(promise)
+		return promise.object.value.New(*handler)
+	})
+	promise.object.function = &jsFunc
+
+	// expose Promise function to Javascript
+	Global().value.Set(promise.Name, *(promise.object.function))
+
+	return hestiaError.OK
+}
+
+func __newGenericJSPromiseHandler(promise *Promise) *js.Func {
+	var handler js.Func
+
+	handler = __funcOf(FUNC_KIND_PROMISE_HANDLER, func(this js.Value, args []js.Value) any {
+		switch {
+		case len(args) < 2:
+			promise.Reject(hestiaError.ENOTRECOVERABLE)
+			__release(FUNC_KIND_PROMISE_HANDLER, &handler)
+			return nil
+		case args[0].Type() != js.TypeFunction,
+			args[1].Type() != js.TypeFunction:
+			promise.Reject(hestiaError.ENOTRECOVERABLE)
+			__release(FUNC_KIND_PROMISE_HANDLER, &handler)
+			return nil
+		default:
+		}
+
+		go func() {
+			ret := promise.Func()
+
+			if ret == hestiaError.OK {
+				// resolve
+				args[0].Invoke(js.ValueOf(promise.Resolve()))
+			} else {
+				//reject
+				args[1].Invoke(js.ValueOf(promise.Reject(hestiaError.OK)))
+			}
+
+			__release(FUNC_KIND_PROMISE_HANDLER, &handler)
+		}()
+
+		return nil
+	})
+
+	return &handler
+}
+
+type __awaitResult struct {
+	value js.Value
+	err   hestiaError.Error
+}
+
+func __await(promise js.Value, timeout time.Duration) (js.Value, hestiaError.Error) {
+	var onFulfilled, onRejected js.Func
+	var result chan *__awaitResult
+	var ret *__awaitResult
+	var timer <-chan time.Time
+	var once sync.Once
+
+	// non-thenable value is an already fulfilled value
+	if promise.Type() != js.TypeObject ||
+		promise.Get(id_JS_THEN).Type() != js.TypeFunction {
+		return promise, hestiaError.OK
+	}
+
+	// buffered to never block the Javascript callbacks
+	result = make(chan *__awaitResult, 1)
+
+	// the callbacks can only be released once settled. Releasing them at
+	// timeout causes a panic when the Promise is settled later on.
+	release := func() {
+		once.Do(func() {
+			__release(FUNC_KIND_AWAIT, &onFulfilled)
+			__release(FUNC_KIND_AWAIT, &onRejected)
+		})
+	}
+
+	onFulfilled = __funcOf(FUNC_KIND_AWAIT, func(this js.Value, args []js.Value) any {
+		ret := &__awaitResult{value: js.Undefined(), err: hestiaError.OK}
+		if len(args) > 0 {
+			ret.value = args[0]
+		}
+
+		result <- ret
+		go release()
+
+		return nil
+	})
+
+	onRejected = __funcOf(FUNC_KIND_AWAIT, func(this js.Value, args []js.Value) any {
+		ret := &__awaitResult{value: js.Undefined(), err: hestiaError.ECANCELED}
+		if len(args) > 0 {
+			ret.value = args[0]
+		}
+
+		result <- ret
+		go release()
+
+		return nil
+	})
+
+	promise.Call(id_JS_THEN, onFulfilled, onRejected)
+
+	if timeout > 0 {
+		timer = time.After(timeout)
+	}
+
+	select {
+	case ret = <-result:
+		return ret.value, ret.err
+	case <-timer:
+		return js.Undefined(), hestiaError.ETIMEDOUT
+	}
+}
+
+func __newEvent(element *Object, this js.Value, event js.Value) (e *Event) {
+	var target js.Value
+
+	target = event.Get(id_JS_EVENT_TARGET)
+	e = &Event{
+		IsBubble:         event.Get(id_JS_EVENT_BUBBLES).Bool(),
+		IsCancellable:    event.Get(id_JS_EVENT_CANCELABLE).Bool(),
+		IsComposed:       event.Get(id_JS_EVENT_COMPOSED).Bool(),
+		Phase:            EventPhase(event.Get(id_JS_EVENT_PHASE).Int()),
+		DefaultPrevented: event.Get(id_JS_EVENT_DEFAULT_PREVENTED).Bool(),
+		IsTrusted:        event.Get(id_JS_EVENT_IS_TRUSTED).Bool(),
+		Timestamp:        event.Get(id_JS_EVENT_TIMESTAMP).Float(),
+		Type:             event.Get(id_JS_EVENT_TYPE).String(),
+		This:             __toEventObject(element, this),
+		Target:           __toEventObject(element, target),
+		OriginalTarget:   __toEventObject(element, target),
+		CurrentTarget: __toEventObject(element,
+			event.Get(id_JS_EVENT_CURRENT_TARGET),
+		),
+	}
+
+	// obtain dropped files if available
+	transfer := event.Get(id_JS_DATA_TRANSFER)
+	if transfer.Type() == js.TypeObject &&
+		transfer.Get(id_JS_FILES).Type() == js.TypeObject &&
+		transfer.Get(id_JS_FILES).Get(id_JS_LENGTH).Int() > 0 {
+		e.Files = __newFiles(transfer.Get(id_JS_FILES))
+	}
+
+	// convert CustomEvent payload back into Go
+	if __isInstanceOf(event, id_JS_CUSTOM_EVENT) {
+		e.Detail, _ = __convertValue(event.Get(id_JS_EVENT_DETAIL),
+			convert_DEPTH_DEFAULT,
+			nil,
+		)
+	}
+
+	// populate interface-specific data. PointerEvent and WheelEvent are
+	// MouseEvent so they are populated alongside.
+	if __isInstanceOf(event, id_JS_MOUSE_EVENT) {
+		e.Mouse = __newMouseEvent(event)
+	}
+
+	switch {
+	case __isInstanceOf(event, id_JS_POINTER_EVENT):
+		e.Pointer = __newPointerEvent(event)
+	case __isInstanceOf(event, id_JS_WHEEL_EVENT):
+		e.Wheel = __newWheelEvent(event)
+	case __isInstanceOf(event, id_JS_KEYBOARD_EVENT):
+		e.Keyboard = __newKeyboardEvent(event)
+	case __isInstanceOf(event, id_JS_INPUT_EVENT):
+		e.Input = __newInputEvent(event)
+	case __isInstanceOf(event, id_JS_TOUCH_EVENT):
+		e.Touch = __newTouchEvent(element, event)
+	}
+
+	return e
+}
+
+func __newEventModifiers(event js.Value) EventModifiers {
+	return EventModifiers{
+		Alt:   event.Get(id_JS_EVENT_ALT_KEY).Truthy(),
+		Ctrl:  event.Get(id_JS_EVENT_CTRL_KEY).Truthy(),
+		Meta:  event.Get(id_JS_EVENT_META_KEY).Truthy(),
+		Shift: event.Get(id_JS_EVENT_SHIFT_KEY).Truthy(),
+	}
+}
+
+func __newInputEvent(event js.Value) *InputEvent {
+	var data js.Value
+
+	data = event.Get(id_JS_EVENT_DATA)
+	if data.IsNull() || data.IsUndefined() {
+		data = js.ValueOf("")
+	}
+
+	return &InputEvent{
+		Data:        data.String(),
+		InputType:   event.Get(id_JS_EVENT_INPUT_TYPE).String(),
+		IsComposing: event.Get(id_JS_EVENT_IS_COMPOSING).Truthy(),
+	}
+}
+
+func __newKeyboardEvent(event js.Value) *KeyboardEvent {
+	return &KeyboardEvent{
+		Key:         event.Get(id_JS_EVENT_KEY).String(),
+		Code:        event.Get(id_JS_EVENT_CODE).String(),
+		Location:    uint8(event.Get(id_JS_EVENT_LOCATION).Int()),
+		Modifiers:   __newEventModifiers(event),
+		Repeat:      event.Get(id_JS_EVENT_REPEAT).Truthy(),
+		IsComposing: event.Get(id_JS_EVENT_IS_COMPOSING).Truthy(),
+	}
+}
+
+func __newMouseEvent(event js.Value) *MouseEvent {
+	return &MouseEvent{
+		ClientX:   event.Get(id_JS_EVENT_CLIENT_X).Float(),
+		ClientY:   event.Get(id_JS_EVENT_CLIENT_Y).Float(),
+		MovementX: event.Get(id_JS_EVENT_MOVEMENT_X).Float(),
+		MovementY: event.Get(id_JS_EVENT_MOVEMENT_Y).Float(),
+		OffsetX:   event.Get(id_JS_EVENT_OFFSET_X).Float(),
+		OffsetY:   event.Get(id_JS_EVENT_OFFSET_Y).Float(),
+		PageX:     event.Get(id_JS_EVENT_PAGE_X).Float(),
+		PageY:     event.Get(id_JS_EVENT_PAGE_Y).Float(),
+		ScreenX:   event.Get(id_JS_EVENT_SCREEN_X).Float(),
+		ScreenY:   event.Get(id_JS_EVENT_SCREEN_Y).Float(),
+		Button:    int16(event.Get(id_JS_EVENT_BUTTON).Int()),
+		Buttons:   uint16(event.Get(id_JS_EVENT_BUTTONS).Int()),
+		Modifiers: __newEventModifiers(event),
+	}
+}
+
+func __newPointerEvent(event js.Value) *PointerEvent {
+	return &PointerEvent{
+		PointerType:        event.Get(id_JS_EVENT_POINTER_TYPE).String(),
+		PointerID:          event.Get(id_JS_EVENT_POINTER_ID).Int(),
+		Width:              event.Get(id_JS_EVENT_WIDTH).Float(),
+		Height:             event.Get(id_JS_EVENT_HEIGHT).Float(),
+		Pressure:           event.Get(id_JS_EVENT_PRESSURE).Float(),
+		TangentialPressure: event.Get(id_JS_EVENT_TANGENTIAL_PRESSURE).Float(),
+		TiltX:              event.Get(id_JS_EVENT_TILT_X).Float(),
+		TiltY:              event.Get(id_JS_EVENT_TILT_Y).Float(),
+		Twist:              event.Get(id_JS_EVENT_TWIST).Float(),
+		IsPrimary:          event.Get(id_JS_EVENT_IS_PRIMARY).Truthy(),
+	}
+}
+
+func __newTouchEvent(element *Object, event js.Value) *TouchEvent {
+	return &TouchEvent{
+		Touches:        __newTouchList(element, event.Get(id_JS_EVENT_TOUCHES)),
+		TargetTouches:  __newTouchList(element, event.Get(id_JS_EVENT_TARGET_TOUCHES)),
+		ChangedTouches: __newTouchList(element, event.Get(id_JS_EVENT_CHANGED_TOUCHES)),
+		Modifiers:      __newEventModifiers(event),
+	}
+}
+
+func __newTouchList(element *Object, list js.Value) (out []*Touch) {
+	var i, length int
+	var touch js.Value
+
+	if list.Type() != js.TypeObject {
+		return []*Touch{}
+	}
+
+	length = list.Get(id_JS_LENGTH).Int()
+	out = make([]*Touch, length)
+
+	for i = 0; i < length; i++ {
+		touch = list.Index(i)
+		out[i] = &Touch{
+			Identifier: touch.Get(id_JS_EVENT_IDENTIFIER).Int(),
+			ClientX:    touch.Get(id_JS_EVENT_CLIENT_X).Float(),
+			ClientY:    touch.Get(id_JS_EVENT_CLIENT_Y).Float(),
+			PageX:      touch.Get(id_JS_EVENT_PAGE_X).Float(),
+			PageY:      touch.Get(id_JS_EVENT_PAGE_Y).Float(),
+			ScreenX:    touch.Get(id_JS_EVENT_SCREEN_X).Float(),
+			ScreenY:    touch.Get(id_JS_EVENT_SCREEN_Y).Float(),
+			RadiusX:    touch.Get(id_JS_EVENT_RADIUS_X).Float(),
+			RadiusY:    touch.Get(id_JS_EVENT_RADIUS_Y).Float(),
+			Force:      touch.Get(id_JS_EVENT_FORCE).Float(),
+			Target:     __toEventObject(element, touch.Get(id_JS_EVENT_TARGET)),
+		}
+	}
+
+	return out
+}
+
+func __newWheelEvent(event js.Value) *WheelEvent {
+	return &WheelEvent{
+		DeltaX:    event.Get(id_JS_EVENT_DELTA_X).Float(),
+		DeltaY:    event.Get(id_JS_EVENT_DELTA_Y).Float(),
+		DeltaZ:    event.Get(id_JS_EVENT_DELTA_Z).Float(),
+		DeltaMode: uint8(event.Get(id_JS_EVENT_DELTA_MODE).Int()),
+	}
+}
+
+func __isInstanceOf(value js.Value, constructor string) bool {
+	var global js.Value
+
+	// some browsers lack certain interfaces (e.g. desktop TouchEvent).
+	global = Global().value.Get(constructor)
+	if global.Type() != js.TypeFunction {
+		return false
+	}
+
+	return value.InstanceOf(global)
+}
+
+func __toEventObject(element *Object, value js.Value) *Object {
+	// reuse the owner Object to keep Go-side identity comparison simple.
+	if value.Equal(*(element.value)) {
+		return element
+	}
+
+	return &Object{
+		value: &value,
+	}
+}
+
+func __applyEventDecision(event js.Value, e *Event, decision EventDecision) {
+	if decision&EVENT_DECISION_PREVENT_DEFAULT != 0 {
+		event.Call(id_JS_EVENT_PREVENT_DEFAULT)
+		e.DefaultPrevented = event.Get(id_JS_EVENT_DEFAULT_PREVENTED).Bool()
+	}
+
+	if decision&EVENT_DECISION_STOP_PROPAGATION != 0 {
+		event.Call(id_JS_EVENT_STOP_PROPAGATION)
+	}
+
+	if decision&EVENT_DECISION_STOP_IMMEDIATE_PROPAGATION != 0 {
+		event.Call(id_JS_EVENT_STOP_IMMEDIATE_PROPAGATION)
+	}
+}
+
+func __attachEvent(element *Object, listener *EventListener) (handle *EventHandle, err hestiaError.Error) {
+	var options map[string]any
+	var bridge func(*Event)
+	var handler js.Func
+	var signal js.Value
+	var ok bool
+
+	// validate delegation selector to prevent panics in the handler
+	if listener.Selector != "" {
+		_, ok = __call(Document().value, id_JS_QUERY_SELECTOR, listener.Selector)
+		if !ok {
+			return nil, hestiaError.EILSEQ
+		}
+	}
+
+	// create the Javascript compatible options list. Once is handled in Go
+	// since the browser would count the unmatched delegated events as well.
+	options = map[string]any{
+		id_JS_EVENT_OPTION_CAPTURE: listener.Capture,
+		id_JS_EVENT_OPTION_PASSIVE: listener.Passive,
+	}
+
+	handle = &EventHandle{
+		listener: listener,
+	}
+
+	// bind the chain bridge (if any) to this attachment only
+	bridge = listener.bridge
+
+	// attach abort signal if available
+	if listener.Signal != nil {
+		signal, err = __abortSignal(listener.Signal)
+		if err != hestiaError.OK {
+			return nil, err
+		}
+
+		options[id_JS_EVENT_OPTION_SIGNAL] = signal
+	}
+
+	// create the Javascript compatible handler
+	handler = __funcOf(FUNC_KIND_EVENT_LISTENER, func(this js.Value, args []js.Value) any {
+		var target js.Value
+		var matched bool
+
+		// filter delegated event by its selector
+		if listener.Selector != "" {
+			target, matched = __delegateTarget(element, args[0], listener.Selector)
+			if !matched {
+				return nil
+			}
+		}
+
+		// detach and release after the first matched dispatch
+		if listener.Once {
+			if !__detachEvent(handle) {
+				return nil // already detached by an earlier dispatch
+			}
+
+			// allow the listener to be added again
+			if listener.handle == handle {
+				listener.handle = nil
+				listener.bridge = nil
+			}
+		}
+
+		// prevent default if set
+		if listener.PreventDefault {
+			args[0].Call(id_JS_EVENT_PREVENT_DEFAULT)
+		}
+
+		// convert the event parameters into Go format
+		e := __newEvent(element, this, args[0])
+		if listener.Selector != "" {
+			e.Target = __toEventObject(element, target)
+		}
+
+		// synchronously decide the event fate if filter is available
+		if listener.Filter != nil {
+			decision := listener.Filter(e)
+			__applyEventDecision(args[0], e, decision)
+
+			if decision&EVENT_DECISION_SKIP != 0 {
+				return nil
+			}
+		}
+
+		// hand over to the chain kernel synchronously to keep the order
+		if bridge != nil {
+			bridge(e)
+			return nil
+		}
+
+		// execute the listener function
+		go listener.Function(e)
+
+		// return nothing since this is a JS function wrapper.
+		return nil
+	})
+
+	// call the JS.addEventListener API
+	element.value.Call(id_JS_ADD_EVENT_LISTENER,
+		listener.Name,
+		handler,
+		options,
+	)
+
+	// save function for later release
+	handle.handler = &Object{
+		value:    element.value,
+		function: &handler,
+	}
+
+	// register for Abort(...) only when there is a handler to release
+	if listener.Signal != nil {
+		err = __attachAbortHandle(listener.Signal, handle)
+		if err != hestiaError.OK {
+			__release(FUNC_KIND_EVENT_LISTENER, handle.handler.function)
+			handle.handler = nil
+			return nil, err
+		}
+	}
+
+	return handle, hestiaError.OK
+}
+
+func __delegateTarget(element *Object, event js.Value, selector string) (js.Value, bool) {
+	var origin, target js.Value
+	var ok bool
+
+	// non-element targets (e.g. window, text node) cannot be matched
+	origin = event.Get(id_JS_EVENT_TARGET)
+	if origin.Type() != js.TypeObject ||
+		origin.Get(id_JS_CLOSEST).Type() != js.TypeFunction {
+		return js.Undefined(), false
+	}
+
+	target, ok = __call(&origin, id_JS_CLOSEST, selector)
+	if !ok || target.IsNull() {
+		return js.Undefined(), false
+	}
+
+	// matched ancestor must be inside the owner (e.g. not the owner's parent)
+	if element.value.Get(id_JS_CONTAINS).Type() == js.TypeFunction &&
+		!element.value.Call(id_JS_CONTAINS, target).Bool() {
+		return js.Undefined(), false
+	}
+
+	return target, true
+}
+
+func __detachEvent(handle *EventHandle) bool {
+	var options map[string]any
+	var listener *EventListener
+	var handler *Object
+
+	listener = handle.listener
+	if listener.Signal != nil {
+		if !__detachAbortHandle(listener.Signal, handle) {
+			return false
+		}
+	}
+
+	// check if handle is already free
+	handler = handle.handler
+	if handler == nil || handler.function == nil {
+		return false
+	}
+
+	// create the Javascript compatible options list
+	options = map[string]any{
+		id_JS_EVENT_OPTION_CAPTURE: listener.Capture,
+	}
+
+	// call the JS.removeEventListener API on its actual owner
+	handler.value.Call(id_JS_REMOVE_EVENT_LISTENER,
+		listener.Name,
+		*(handler.function),
+		options,
+	)
+
+	// release saved function
+	__release(FUNC_KIND_EVENT_LISTENER, handler.function)
+	handle.handler = nil
+
+	return true
+}
+
+func __dispatch(target *Object,
+	constructor string,
+	name string,
+	detail any,
+	options *EventOptions) (cancelled bool, err hestiaError.Error) {
+	var init map[string]any
+	var event js.Value
+
+	if IsObjectOK(target) != hestiaError.OK {
+		return false, hestiaError.EOWNERDEAD
+	}
+
+	if name == "" {
+		return false, hestiaError.ENODATA
+	}
+
+	if options == nil {
+		options = &EventOptions{}
+	}
+
+	// create the Javascript compatible event init dictionary
+	init = map[string]any{
+		id_JS_EVENT_BUBBLES:    options.IsBubble,
+		id_JS_EVENT_CANCELABLE: options.IsCancellable,
+		id_JS_EVENT_COMPOSED:   options.IsComposed,
+	}
+
+	if constructor == id_JS_CUSTOM_EVENT {
+		init[id_JS_EVENT_DETAIL] = detail
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			cancelled = false
+			err = hestiaError.EPROTO
+		}
+	}()
+
+	event = Global().value.Get(constructor).New(name, init)
+
+	// dispatchEvent returns false when the event was cancelled.
+	return !target.value.Call(id_JS_DISPATCH_EVENT, event).Bool(),
+		hestiaError.OK
+}
+
+func __funcOf(kind FuncKind, fx func(this js.Value, args []js.Value) any) js.Func {
+	funcRegistry.mutex.Lock()
+	funcRegistry.count[kind]++
+	funcRegistry.mutex.Unlock()
+
+	return js.FuncOf(fx)
+}
+
+func __release(kind FuncKind, fx *js.Func) {
+	if fx == nil {
+		return
+	}
+
+	fx.Release()
+
+	funcRegistry.mutex.Lock()
+	funcRegistry.count[kind]--
+	funcRegistry.mutex.Unlock()
+}
+
+func __call(value *js.Value, method string, args ...any) (ret js.Value, ok bool) {
+	// Javascript exceptions (e.g. DOMException: SyntaxError) are raised
+	// as Go panics by syscall/js. Recover them into a failed status.
+	defer func() {
+		if r := recover(); r != nil {
+			ret = js.Undefined()
+			ok = false
+		}
+	}()
+
+	ret = value.Call(method, args...)
+
+	return ret, true
+}
+
+func __convertValue(value js.Value, depth uint, parents []js.Value) (out any, err hestiaError.Error) {
+	var list map[string]any
+	var array []any
+	var keys js.Value
+	var key string
+	var i, length int
+
+	switch value.Type() {
+	case js.TypeBoolean:
+		return value.Bool(), hestiaError.OK
+	case js.TypeNumber:
+		return value.Float(), hestiaError.OK
+	case js.TypeNull, js.TypeUndefined:
+		return nil, hestiaError.OK
+	case js.TypeFunction:
+		return "<Javascript Function>", hestiaError.OK
+	case js.TypeObject:
+	case js.TypeString:
+		fallthrough
+	default:
+		return value.String(), hestiaError.OK
+	}
+
+	// guard against cyclic and overly nested objects
+	if uint(len(parents)) >= depth {
+		return nil, hestiaError.ELOOP
+	}
+
+	for i = range parents {
+		if parents[i].Equal(value) {
+			return nil, hestiaError.ELOOP
+		}
+	}
+	parents = append(parents, value)
+
+	// convert Javascript Array
+	if Global().value.Get(id_JS_ARRAY).Call(id_JS_IS_ARRAY, value).Bool() {
+		length = value.Get(id_JS_LENGTH).Int()
+		array = make([]any, length)
+
+		for i = 0; i < length; i++ {
+			array[i], err = __convertValue(value.Index(i), depth, parents)
+			if err != hestiaError.OK {
+				return nil, err
+			}
+		}
+
+		return array, hestiaError.OK
+	}
+
+	// convert Javascript Object
+	keys = Global().value.Get(id_JS_OBJECT).Call(id_JS_KEYS, value)
+	length = keys.Get(id_JS_LENGTH).Int()
+	list = make(map[string]any, length)
+
+	for i = 0; i < length; i++ {
+		key = keys.Index(i).String()
+
+		list[key], err = __convertValue(value.Get(key), depth, parents)
+		if err != hestiaError.OK {
+			return nil, err
+		}
+	}
+
+	return list, hestiaError.OK
+}
+
+func __getTyped(element *Object, key string, kind js.Type) (ret js.Value, err hestiaError.Error) {
+	if IsObjectOK(element) != hestiaError.OK {
+		return js.Undefined(), hestiaError.EOWNERDEAD
+	}
+
+	if key == "" {
+		return js.Undefined(), hestiaError.ENODATA
+	}
+
+	ret = element.value.Get(key)
+	switch {
+	case ret.IsNull(), ret.IsUndefined():
+		return js.Undefined(), hestiaError.ENOPROTOOPT
+	case ret.Type() != kind:
+		return js.Undefined(), hestiaError.EPROTOTYPE
+	}
+
+	return ret, hestiaError.OK
+}
+
+func __insertAdjacent(element *Object, method string, position HTMLPosition, value string) hestiaError.Error {
+	var where string
+	var ok bool
+
+	if IsObjectOK(element) != hestiaError.OK {
+		return hestiaError.EOWNERDEAD
+	}
+
+	switch position {
+	case HTML_POSITION_BEFORE_BEGIN:
+		where = position_BEFORE_BEGIN
+	case HTML_POSITION_AFTER_BEGIN:
+		where = position_AFTER_BEGIN
+	case HTML_POSITION_BEFORE_END:
+		where = position_BEFORE_END
+	case HTML_POSITION_AFTER_END:
+		where = position_AFTER_END
+	default:
+		return hestiaError.EINVAL
+	}
+
+	_, ok = __call(element.value, method, where, value)
+	if !ok {
+		return hestiaError.EPERM
+	}
+
+	return hestiaError.OK
+}
+
+func __toJSArgs(args []any) (out []any, ok bool) {
+	var obj *Object
+
+	out = make([]any, len(args))
+	for i, arg := range args {
+		// unwrap hestiaWASM.Object back to its Javascript value
+		if obj, ok = arg.(*Object); ok {
+			if obj == nil || obj.value == nil {
+				return nil, false
+			}
+
+			out[i] = *(obj.value)
+			continue
+		}
+
+		if IsTypeConvertable(arg) != hestiaError.OK {
+			return nil, false
+		}
+
+		out[i] = arg
+	}
+
+	return out, true
+}
+
+func __toObject(value js.Value) (*Object, hestiaError.Error) {
+	if value.IsNull() || value.IsUndefined() {
+		return nil, hestiaError.ENOPROTOOPT
+	}
+
+	return &Object{
+		value: &value,
+	}, hestiaError.OK
+}
+
+func __toObjectList(list js.Value) (out []*Object) {
+	var i, length int
+
+	length = list.Get(id_JS_LENGTH).Int()
+	out = make([]*Object, 0, length)
+
+	// each Object needs its own value to point at
+	for i = 0; i < length; i++ {
+		item := list.Index(i)
+		out = append(out, &Object{
+			value: &item,
+		})
+	}
+
+	return out
 }
 
 func _isEventListenerOK(element *EventListener) hestiaError.Error {
@@ -431,7 +2272,7 @@ func _isEventListenerOK(element *EventListener) hestiaError.Error {
 		return hestiaError.EBADF
 	}
 
-	if element.Function == nil {
+	if element.Function == nil && element.bridge == nil {
 		return hestiaError.ENOENT
 	}
 
@@ -0,0 +1,150 @@
+// Copyright 2022 "Holloway" Chew, Kean Ho <hollowaykeanho@gmail.com>
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package hestiaWASM
+
+import (
+	"hestiaGo/hestiaError"
+	"io"
+	"time"
+)
+
+const (
+	http_METHOD_DEFAULT = "GET"
+)
+
+// HTTPCredentials is the credentials (cookies) policy of HTTPRequest.
+//
+// It is only effective in wasm builds (Javascript `fetch` credentials).
+type HTTPCredentials uint8
+
+// HTTPCredentials representations ID
+const (
+	HTTP_CREDENTIALS_SAME_ORIGIN HTTPCredentials = 0
+	HTTP_CREDENTIALS_OMIT        HTTPCredentials = 1
+	HTTP_CREDENTIALS_INCLUDE     HTTPCredentials = 2
+	http_CREDENTIALS_MAX         HTTPCredentials = 3
+)
+
+// HTTPMode is the cross-origin mode of HTTPRequest.
+//
+// It is only effective in wasm builds (Javascript `fetch` mode).
+type HTTPMode uint8
+
+// HTTPMode representations ID
+const (
+	HTTP_MODE_CORS        HTTPMode = 0
+	HTTP_MODE_NO_CORS     HTTPMode = 1
+	HTTP_MODE_SAME_ORIGIN HTTPMode = 2
+	http_MODE_MAX         HTTPMode = 3
+)
+
+// HTTPRequest is the HTTP request for `HTTPDo(...)`.
+type HTTPRequest struct {
+	// Headers is the list of request headers.
+	Headers map[string]string
+
+	// Body is the request body. Can be `nil`.
+	Body []byte
+
+	// Method is the HTTP method (e.g. `"POST"`).
+	//
+	// Default (`""`) is `"GET"`.
+	Method string
+
+	// URL is the request URL. Relative URL is resolved against the page in
+	// wasm builds and is invalid (`hestiaError.EINVAL`) in other builds.
+	URL string
+
+	// Timeout is the maximum duration for the entire request including
+	// reading the response Body.
+	//
+	// Default (`0`) is no timeout.
+	Timeout time.Duration
+
+	// Credentials is the cookies policy. See HTTPCredentials.
+	Credentials HTTPCredentials
+
+	// Mode is the cross-origin mode. See HTTPMode.
+	Mode HTTPMode
+}
+
+// HTTPResponse is the HTTP response from `HTTPDo(...)`.
+type HTTPResponse struct {
+	// Headers is the list of response headers with lowercase keys.
+	Headers map[string]string
+
+	// Body is the streaming response body.
+	//
+	// It is read chunk by chunk as received. It is your responsibility to
+	// close it after use, even when it is not read.
+	Body io.ReadCloser
+
+	// StatusText is the HTTP status message (e.g. `"Not Found"`).
+	StatusText string
+
+	// URL is the final URL after redirects.
+	URL string
+
+	// Status is the HTTP status code (e.g. `404`).
+	//
+	// Non-2xx statuses are **NOT** treated as errors.
+	Status uint16
+}
+
+// HTTPDo sends a HTTP request and returns its response.
+//
+// It is implemented over Javascript `fetch` in wasm builds and over Go
+// `net/http` in other builds so the same code can be tested against
+// `httptest.Server` natively.
+//
+// Like `Await(...)`, it **SHALL NOT** be called inside a Javascript callback.
+// The same applies to reading the HTTPResponse's Body in wasm builds. In all
+// builds, its read error is a Go error carrying the `hestiaError.ERROR_*`
+// message (`ERROR_ETIMEDOUT` or `ERROR_ECONNRESET`).
+//
+// It accepts the following parameters:
+//   1. `request` - the HTTP request.
+//
+// It shall returns:
+//   1. HTTPResponse, hestiaError.OK | `0` - operation successful.
+//   2. `nil`, hestiaError.EOWNERDEAD - given `request` is `nil`.
+//   3. `nil`, hestiaError.EDESTADDRREQ - given `request` has no URL.
+//   4. `nil`, hestiaError.EINVAL - given `request` has malformed values
+//                                  (e.g. a relative URL in non-WASM builds).
+//   5. `nil`, hestiaError.ETIMEDOUT - the request timed out.
+//   6. `nil`, hestiaError.ENETUNREACH - the request failed (e.g. network
+//                                       error or blocked by CORS).
+func HTTPDo(request *HTTPRequest) (*HTTPResponse, hestiaError.Error) {
+	if request == nil {
+		return nil, hestiaError.EOWNERDEAD
+	}
+
+	if request.URL == "" {
+		return nil, hestiaError.EDESTADDRREQ
+	}
+
+	if request.Credentials >= http_CREDENTIALS_MAX ||
+		request.Mode >= http_MODE_MAX {
+		return nil, hestiaError.EINVAL
+	}
+
+	return _httpDo(request)
+}
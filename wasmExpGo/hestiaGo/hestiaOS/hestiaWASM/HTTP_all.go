@@ -0,0 +1,113 @@
+// Copyright 2022 "Holloway" Chew, Kean Ho <hollowaykeanho@gmail.com>
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+//go:build !wasm
+// +build !wasm
+
+package hestiaWASM
+
+import (
+	"bytes"
+	"errors"
+	"hestiaGo/hestiaError"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// httpBody is the `net/http` response body with the wasm build's read errors.
+type httpBody struct {
+	body io.ReadCloser
+}
+
+func (body *httpBody) Read(p []byte) (n int, err error) {
+	var timeout interface{ Timeout() bool }
+
+	n, err = body.body.Read(p)
+	if err == nil || err == io.EOF {
+		return n, err
+	}
+
+	if errors.As(err, &timeout) && timeout.Timeout() {
+		return n, __toGoError(hestiaError.ETIMEDOUT)
+	}
+
+	return n, __toGoError(hestiaError.ECONNRESET)
+}
+
+func (body *httpBody) Close() error {
+	return body.body.Close()
+}
+
+func _httpDo(request *HTTPRequest) (*HTTPResponse, hestiaError.Error) {
+	var client *http.Client
+	var req *http.Request
+	var resp *http.Response
+	var headers map[string]string
+	var method, key string
+	var values []string
+	var timeout interface{ Timeout() bool }
+	var err error
+
+	method = request.Method
+	if method == "" {
+		method = http_METHOD_DEFAULT
+	}
+
+	req, err = http.NewRequest(method, request.URL, bytes.NewReader(request.Body))
+	if err != nil {
+		return nil, hestiaError.EINVAL
+	}
+
+	// there is no page to resolve a relative URL against like `fetch`
+	if !req.URL.IsAbs() || req.URL.Host == "" {
+		return nil, hestiaError.EINVAL
+	}
+
+	for key = range request.Headers {
+		req.Header.Set(key, request.Headers[key])
+	}
+
+	client = &http.Client{
+		Timeout: request.Timeout,
+	}
+
+	resp, err = client.Do(req)
+	if err != nil {
+		if errors.As(err, &timeout) && timeout.Timeout() {
+			return nil, hestiaError.ETIMEDOUT
+		}
+
+		return nil, hestiaError.ENETUNREACH
+	}
+
+	headers = map[string]string{}
+	for key, values = range resp.Header {
+		headers[strings.ToLower(key)] = strings.Join(values, ", ")
+	}
+
+	return &HTTPResponse{
+		Headers:    headers,
+		Body:       &httpBody{body: resp.Body},
+		StatusText: http.StatusText(resp.StatusCode),
+		URL:        resp.Request.URL.String(),
+		Status:     uint16(resp.StatusCode),
+	}, hestiaError.OK
+}
@@ -0,0 +1,193 @@
+// Copyright 2022 "Holloway" Chew, Kean Ho <hollowaykeanho@gmail.com>
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+//go:build !wasm
+// +build !wasm
+
+package hestiaWASM
+
+import (
+	"hestiaGo/hestiaError"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestHTTPDoStatusAndHeaders(t *testing.T) {
+	var server *httptest.Server
+	var response *HTTPResponse
+	var body []byte
+	var err hestiaError.Error
+	var ret error
+
+	server = httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("X-Method", r.Method)
+			w.Header().Set("X-Echo", r.Header.Get("X-Request"))
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = io.Copy(w, r.Body)
+		},
+	))
+	defer server.Close()
+
+	response, err = HTTPDo(&HTTPRequest{
+		URL:     server.URL + "/missing",
+		Method:  "POST",
+		Headers: map[string]string{"X-Request": "hello"},
+		Body:    []byte("payload"),
+	})
+	if err != hestiaError.OK {
+		t.Fatalf("HTTPDo(...) = %v, want OK", err)
+	}
+	defer response.Body.Close()
+
+	if response.Status != http.StatusNotFound {
+		t.Errorf("Status = %d, want %d", response.Status, http.StatusNotFound)
+	}
+
+	if response.StatusText != "Not Found" {
+		t.Errorf("StatusText = %q, want %q", response.StatusText, "Not Found")
+	}
+
+	if response.URL != server.URL+"/missing" {
+		t.Errorf("URL = %q, want %q", response.URL, server.URL+"/missing")
+	}
+
+	if response.Headers["x-method"] != "POST" {
+		t.Errorf("x-method = %q, want %q", response.Headers["x-method"], "POST")
+	}
+
+	if response.Headers["x-echo"] != "hello" {
+		t.Errorf("x-echo = %q, want %q", response.Headers["x-echo"], "hello")
+	}
+
+	body, ret = io.ReadAll(response.Body)
+	if ret != nil || string(body) != "payload" {
+		t.Errorf("Body = %q, %v, want %q", body, ret, "payload")
+	}
+}
+
+func TestHTTPDoStreamingBody(t *testing.T) {
+	var server *httptest.Server
+	var response *HTTPResponse
+	var release chan struct{}
+	var buffer [16]byte
+	var rest []byte
+	var n int
+	var err hestiaError.Error
+	var ret error
+
+	release = make(chan struct{})
+	server = httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write([]byte("first"))
+			w.(http.Flusher).Flush()
+
+			<-release
+			_, _ = w.Write([]byte("second"))
+		},
+	))
+	defer server.Close()
+	defer close(release)
+
+	response, err = HTTPDo(&HTTPRequest{URL: server.URL})
+	if err != hestiaError.OK {
+		t.Fatalf("HTTPDo(...) = %v, want OK", err)
+	}
+	defer response.Body.Close()
+
+	// the first chunk arrives while the server is still holding the second
+	n, ret = response.Body.Read(buffer[:])
+	if ret != nil || string(buffer[:n]) != "first" {
+		t.Fatalf("Read(...) = %q, %v, want %q", buffer[:n], ret, "first")
+	}
+
+	release <- struct{}{}
+
+	rest, ret = io.ReadAll(response.Body)
+	if ret != nil || string(rest) != "second" {
+		t.Fatalf("ReadAll(...) = %q, %v, want %q", rest, ret, "second")
+	}
+}
+
+func TestHTTPDoTimeout(t *testing.T) {
+	var server *httptest.Server
+	var response *HTTPResponse
+	var release chan struct{}
+	var err hestiaError.Error
+	var ret error
+
+	release = make(chan struct{})
+	server = httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path == "/body" {
+				w.WriteHeader(http.StatusOK)
+				w.(http.Flusher).Flush()
+			}
+
+			<-release
+		},
+	))
+	defer server.Close()
+	defer close(release)
+
+	_, err = HTTPDo(&HTTPRequest{
+		URL:     server.URL + "/header",
+		Timeout: 50 * time.Millisecond,
+	})
+	if err != hestiaError.ETIMEDOUT {
+		t.Fatalf("HTTPDo(...) = %v, want ETIMEDOUT", err)
+	}
+
+	response, err = HTTPDo(&HTTPRequest{
+		URL:     server.URL + "/body",
+		Timeout: 50 * time.Millisecond,
+	})
+	if err != hestiaError.OK {
+		t.Fatalf("HTTPDo(...) = %v, want OK", err)
+	}
+	defer response.Body.Close()
+
+	_, ret = io.ReadAll(response.Body)
+	if ret == nil || ret.Error() != hestiaError.ERROR_ETIMEDOUT {
+		t.Fatalf("ReadAll(...) = %v, want %q", ret, hestiaError.ERROR_ETIMEDOUT)
+	}
+}
+
+func TestHTTPDoInvalidRequest(t *testing.T) {
+	var err hestiaError.Error
+
+	_, err = HTTPDo(nil)
+	if err != hestiaError.EOWNERDEAD {
+		t.Errorf("HTTPDo(nil) = %v, want EOWNERDEAD", err)
+	}
+
+	_, err = HTTPDo(&HTTPRequest{})
+	if err != hestiaError.EDESTADDRREQ {
+		t.Errorf("HTTPDo(no URL) = %v, want EDESTADDRREQ", err)
+	}
+
+	_, err = HTTPDo(&HTTPRequest{URL: "/relative"})
+	if err != hestiaError.EINVAL {
+		t.Errorf("HTTPDo(relative URL) = %v, want EINVAL", err)
+	}
+}
@@ -0,0 +1,256 @@
+// Copyright 2022 "Holloway" Chew, Kean Ho <hollowaykeanho@gmail.com>
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+//go:build wasm
+// +build wasm
+
+package hestiaWASM
+
+import (
+	"bytes"
+	"hestiaGo/hestiaError"
+	"io"
+	"strings"
+	"syscall/js"
+	"time"
+)
+
+const (
+	http_CREDENTIALS_INCLUDE     = "include"
+	http_CREDENTIALS_OMIT        = "omit"
+	http_CREDENTIALS_SAME_ORIGIN = "same-origin"
+	http_MODE_CORS               = "cors"
+	http_MODE_NO_CORS            = "no-cors"
+	http_MODE_SAME_ORIGIN        = "same-origin"
+)
+
+// httpBody is the streaming Javascript `ReadableStream` response body.
+type httpBody struct {
+	reader  js.Value
+	buffer  []byte
+	abort   *AbortController
+	timer   *time.Timer
+	done    bool
+	timeout bool
+}
+
+func (body *httpBody) Read(p []byte) (n int, err error) {
+	var ret, array js.Value
+	var code hestiaError.Error
+
+	for len(body.buffer) == 0 {
+		if body.done {
+			return 0, io.EOF
+		}
+
+		ret, code = __await(body.reader.Call(id_JS_HTTP_READ), 0)
+		if code != hestiaError.OK {
+			__closeHTTPBody(body)
+			if aborted, _ := IsAborted(body.abort); aborted {
+				return 0, __toGoError(hestiaError.ETIMEDOUT)
+			}
+
+			return 0, __toGoError(hestiaError.ECONNRESET)
+		}
+
+		if ret.Get(id_JS_HTTP_DONE).Bool() {
+			__closeHTTPBody(body)
+			return 0, io.EOF
+		}
+
+		array = ret.Get(id_JS_HTTP_VALUE)
+		body.buffer = make([]byte, array.Get(id_JS_LENGTH).Int())
+		js.CopyBytesToGo(body.buffer, array)
+	}
+
+	n = copy(p, body.buffer)
+	body.buffer = body.buffer[n:]
+
+	return n, nil
+}
+
+func (body *httpBody) Close() error {
+	if !body.done {
+		// release the unread stream
+		body.reader.Call(id_JS_HTTP_CANCEL)
+	}
+
+	__closeHTTPBody(body)
+
+	return nil
+}
+
+func _httpDo(request *HTTPRequest) (*HTTPResponse, hestiaError.Error) {
+	var init, headers map[string]any
+	var fetch, ret, stream js.Value
+	var controller *AbortController
+	var signal *Object
+	var timer *time.Timer
+	var response *HTTPResponse
+	var array *Object
+	var method, key string
+	var err hestiaError.Error
+	var ok bool
+
+	fetch = Global().value.Get(id_JS_HTTP_FETCH)
+	if fetch.Type() != js.TypeFunction {
+		return nil, hestiaError.EOPNOTSUPP
+	}
+
+	method = request.Method
+	if method == "" {
+		method = http_METHOD_DEFAULT
+	}
+
+	// create the Javascript compatible request options
+	headers = map[string]any{}
+	for key = range request.Headers {
+		headers[key] = request.Headers[key]
+	}
+
+	controller = &AbortController{}
+	signal, _ = GetAbortSignal(controller)
+
+	init = map[string]any{
+		id_JS_HTTP_METHOD:      method,
+		id_JS_HTTP_HEADERS:     headers,
+		id_JS_HTTP_CREDENTIALS: __httpCredentials(request.Credentials),
+		id_JS_HTTP_MODE:        __httpMode(request.Mode),
+		id_JS_SIGNAL:           *(signal.value),
+	}
+
+	// `fetch` rejects any body (even empty) for methods like GET
+	if len(request.Body) > 0 {
+		array, err = NewUint8Array(request.Body)
+		if err != hestiaError.OK {
+			return nil, hestiaError.EINVAL
+		}
+
+		init[id_JS_HTTP_BODY] = *(array.value)
+	}
+
+	// abort the entire request including the body reading on timeout
+	if request.Timeout > 0 {
+		timer = time.AfterFunc(request.Timeout, func() {
+			_ = Abort(controller)
+		})
+	}
+
+	ret, ok = __call(Global().value, id_JS_HTTP_FETCH, request.URL, init)
+	if !ok {
+		__stopHTTPTimer(timer)
+		return nil, hestiaError.EINVAL
+	}
+
+	ret, err = __await(ret, 0)
+	if err != hestiaError.OK {
+		__stopHTTPTimer(timer)
+
+		if aborted, _ := IsAborted(controller); aborted {
+			return nil, hestiaError.ETIMEDOUT
+		}
+
+		return nil, hestiaError.ENETUNREACH
+	}
+
+	response = &HTTPResponse{
+		Headers:    __newHTTPHeaders(ret.Get(id_JS_HTTP_HEADERS)),
+		StatusText: ret.Get(id_JS_HTTP_STATUS_TEXT).String(),
+		URL:        ret.Get(id_JS_HTTP_URL).String(),
+		Status:     uint16(ret.Get(id_JS_HTTP_STATUS).Int()),
+	}
+
+	// opaque and bodiless responses (e.g. `no-cors`, `204`) have no stream
+	stream = ret.Get(id_JS_HTTP_BODY)
+	if stream.Type() != js.TypeObject {
+		__stopHTTPTimer(timer)
+		response.Body = io.NopCloser(bytes.NewReader(nil))
+
+		return response, hestiaError.OK
+	}
+
+	response.Body = &httpBody{
+		reader: stream.Call(id_JS_HTTP_GET_READER),
+		abort:  controller,
+		timer:  timer,
+	}
+
+	return response, hestiaError.OK
+}
+
+// NOTE: all functions below are sub-functions. Please use the global version
+// since it has proper guarding like `nil` object checking.
+
+func __closeHTTPBody(body *httpBody) {
+	body.done = true
+	body.buffer = nil
+
+	if body.timer != nil {
+		body.timer.Stop()
+	}
+}
+
+func __httpCredentials(credentials HTTPCredentials) string {
+	switch credentials {
+	case HTTP_CREDENTIALS_OMIT:
+		return http_CREDENTIALS_OMIT
+	case HTTP_CREDENTIALS_INCLUDE:
+		return http_CREDENTIALS_INCLUDE
+	default:
+		return http_CREDENTIALS_SAME_ORIGIN
+	}
+}
+
+func __httpMode(mode HTTPMode) string {
+	switch mode {
+	case HTTP_MODE_NO_CORS:
+		return http_MODE_NO_CORS
+	case HTTP_MODE_SAME_ORIGIN:
+		return http_MODE_SAME_ORIGIN
+	default:
+		return http_MODE_CORS
+	}
+}
+
+func __newHTTPHeaders(headers js.Value) (out map[string]string) {
+	var iterator, entry, pair js.Value
+
+	out = map[string]string{}
+
+	// Headers.entries() yields lowercase [key, value] pairs
+	iterator = headers.Call(id_JS_HTTP_ENTRIES)
+	for {
+		entry = iterator.Call(id_JS_HTTP_NEXT)
+		if entry.Get(id_JS_HTTP_DONE).Bool() {
+			break
+		}
+
+		pair = entry.Get(id_JS_HTTP_VALUE)
+		out[strings.ToLower(pair.Index(0).String())] = pair.Index(1).String()
+	}
+
+	return out
+}
+
+func __stopHTTPTimer(timer *time.Timer) {
+	if timer != nil {
+		timer.Stop()
+	}
+}
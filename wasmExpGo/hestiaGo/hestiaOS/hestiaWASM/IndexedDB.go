@@ -0,0 +1,946 @@
+// Copyright 2022 "Holloway" Chew, Kean Ho <hollowaykeanho@gmail.com>
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package hestiaWASM
+
+import (
+	"hestiaGo/hestiaError"
+	"reflect"
+	"sync"
+)
+
+// IDBDirection is the iterating direction of an IndexedDB cursor.
+type IDBDirection uint8
+
+const (
+	// IDB_DIRECTION_NEXT iterates in ascending key order.
+	IDB_DIRECTION_NEXT IDBDirection = 0
+
+	// IDB_DIRECTION_NEXT_UNIQUE iterates in ascending key order while
+	// skipping duplicated index keys.
+	IDB_DIRECTION_NEXT_UNIQUE IDBDirection = 1
+
+	// IDB_DIRECTION_PREV iterates in descending key order.
+	IDB_DIRECTION_PREV IDBDirection = 2
+
+	// IDB_DIRECTION_PREV_UNIQUE iterates in descending key order while
+	// skipping duplicated index keys.
+	IDB_DIRECTION_PREV_UNIQUE IDBDirection = 3
+
+	idb_DIRECTION_MAX IDBDirection = 4
+)
+
+// IDBMode is the mode of an IndexedDB transaction.
+type IDBMode uint8
+
+const (
+	// IDB_MODE_READONLY only allows reading.
+	IDB_MODE_READONLY IDBMode = 0
+
+	// IDB_MODE_READWRITE allows both reading and writing.
+	IDB_MODE_READWRITE IDBMode = 1
+
+	idb_MODE_MAX IDBMode = 2
+)
+
+const (
+	idb_VERSION_DEFAULT = 1
+)
+
+// IDB is the hestiaWASM adapter for a Javascript IndexedDB database.
+//
+// It is implemented over Javascript `indexedDB` in wasm builds and over an
+// in-memory database shared by Name within the process in other builds so
+// the same logic can be tested natively.
+//
+// All operations are blocking calls so they **SHALL NOT** be called inside a
+// Javascript callback. The records are stored in the same Go format as
+// `Convert(...)` (e.g. numbers are `float64`) and can be filled into Go data
+// structures using the `js` field tag like `Decode(...)`.
+//
+// The keys are limited to `string`, numbers, and slices of them which are
+// ordered as numbers, then strings, then slices like Javascript.
+type IDB struct {
+	// OnUpgrade is called to create or migrate the stores and indexes when
+	// the database is created or its Version is raised.
+	//
+	// It is executed synchronously inside the Javascript callback so it
+	// **SHALL** only use the `IDBCreate...` and `IDBDelete...` functions and
+	// never block. Returning an error aborts the upgrade.
+	OnUpgrade func(upgrade *IDBUpgrade) hestiaError.Error
+
+	// OnVersionChange is called when another page wants to upgrade the
+	// database.
+	//
+	// This function is executed in a separate goroutine. Default (`nil`)
+	// closes the database so the other page is not blocked.
+	OnVersionChange func()
+
+	// Name is the database name.
+	Name string
+
+	// Version is the database schema version.
+	//
+	// Default (`0`) is `1`.
+	Version uint64
+
+	handle *idbHandle
+	mutex  sync.Mutex
+}
+
+// IDBCursor is the Go format of Javascript IDBCursorWithValue.
+//
+// It is only valid inside its transaction.
+type IDBCursor struct {
+	// Key is the current key (the index key when iterating an index).
+	Key any
+
+	// PrimaryKey is the current record's key in its object store.
+	PrimaryKey any
+
+	// Value is the current record in Go format.
+	Value any
+
+	handle *idbCursorHandle
+}
+
+// IDBIndexOptions is the configurations for creating an index.
+type IDBIndexOptions struct {
+	// Name is the index name.
+	Name string
+
+	// KeyPath is the dotted path to the indexed property (e.g. `"user.id"`).
+	KeyPath string
+
+	// Unique rejects records with a duplicated index key.
+	Unique bool
+
+	// MultiEntry indexes each item when the indexed property is a slice.
+	MultiEntry bool
+}
+
+// IDBKeyRange is the Go format of Javascript IDBKeyRange.
+//
+// A `nil` bound is unbounded. Setting the same key for both Lower and Upper
+// matches only that key.
+type IDBKeyRange struct {
+	// Lower is the lower bound key.
+	Lower any
+
+	// Upper is the upper bound key.
+	Upper any
+
+	// LowerOpen excludes Lower from the range.
+	LowerOpen bool
+
+	// UpperOpen excludes Upper from the range.
+	UpperOpen bool
+}
+
+// IDBQuery is the query for reading multiple records.
+type IDBQuery struct {
+	// Range is the key range to match.
+	//
+	// Default (`nil`) matches all keys.
+	Range *IDBKeyRange
+
+	// Store is the object store name.
+	Store string
+
+	// Index is the index name in Store.
+	//
+	// Default (`""`) queries Store by its primary keys.
+	Index string
+
+	// Limit is the maximum records to read.
+	//
+	// Default (`0`) is unlimited.
+	Limit uint
+
+	// Direction is the iterating direction.
+	//
+	// Default (`0`) is IDB_DIRECTION_NEXT.
+	Direction IDBDirection
+}
+
+// IDBStoreOptions is the configurations for creating an object store.
+type IDBStoreOptions struct {
+	// Name is the object store name.
+	Name string
+
+	// KeyPath is the dotted path to the in-line key property.
+	//
+	// Default (`""`) uses out-of-line keys given when writing.
+	KeyPath string
+
+	// AutoIncrement generates increasing numeric keys when not given.
+	AutoIncrement bool
+}
+
+// IDBTransaction is the Go format of Javascript IDBTransaction.
+//
+// It is only valid inside `IDBTransact(...)`.
+type IDBTransaction struct {
+	handle *idbTxHandle
+
+	// Mode is the transaction mode.
+	Mode IDBMode
+}
+
+// IDBUpgrade is the Go format of Javascript versionchange transaction.
+//
+// It is only valid inside IDB.OnUpgrade.
+type IDBUpgrade struct {
+	handle *idbUpgradeHandle
+
+	// OldVersion is the existing version (`0` for a new database).
+	OldVersion uint64
+
+	// NewVersion is the requested version.
+	NewVersion uint64
+}
+
+// IDBAdd writes a new record into an object store.
+//
+// Unlike `IDBPut(...)`, it fails when the key already exists. Like
+// Javascript, a failed operation aborts the whole transaction.
+//
+// It accepts the following parameters:
+//   1. `tx` - the IDB_MODE_READWRITE transaction.
+//   2. `store` - the object store name.
+//   3. `value` - the Go value to write.
+//   4. `key` - the out-of-line key. It **MUST** be `nil` for stores with
+//              KeyPath or to auto-increment.
+//
+// It shall returns:
+//   1. key, hestiaError.OK | `0` - operation successful.
+//   2. `nil`, hestiaError.EEXIST - the key or a unique index key exists.
+//   3. Any error from `IDBPut(...)`.
+func IDBAdd(tx *IDBTransaction, store string, value any, key any) (any, hestiaError.Error) {
+	return __idbWrite(tx, store, value, key, true)
+}
+
+// IDBClear deletes all records in an object store.
+//
+// It accepts the following parameters:
+//   1. `tx` - the IDB_MODE_READWRITE transaction.
+//   2. `store` - the object store name.
+//
+// It shall returns:
+//   1. hestiaError.OK | `0` - operation successful.
+//   2. hestiaError.EOWNERDEAD - given `tx` is unusable.
+//   3. hestiaError.ENOENT - given `store` is not in the transaction.
+//   4. hestiaError.EROFS - given `tx` is IDB_MODE_READONLY.
+//   5. hestiaError.EBADFD - given `tx` is already finished.
+func IDBClear(tx *IDBTransaction, store string) hestiaError.Error {
+	if tx == nil || tx.handle == nil {
+		return hestiaError.EOWNERDEAD
+	}
+
+	return _idbClear(tx, store)
+}
+
+// IDBClose closes an IndexedDB database.
+//
+// It accepts the following parameters:
+//   1. `db` - the IDB.
+//
+// It shall returns:
+//   1. hestiaError.OK | `0` - operation successful.
+//   2. hestiaError.EOWNERDEAD - given `db` is `nil`.
+//   3. hestiaError.ENOTCONN - given `db` is not opened.
+func IDBClose(db *IDB) hestiaError.Error {
+	if db == nil {
+		return hestiaError.EOWNERDEAD
+	}
+
+	db.mutex.Lock()
+	defer db.mutex.Unlock()
+
+	if db.handle == nil {
+		return hestiaError.ENOTCONN
+	}
+
+	_idbClose(db)
+	db.handle = nil
+
+	return hestiaError.OK
+}
+
+// IDBCount counts the records matching a query.
+//
+// It accepts the following parameters:
+//   1. `tx` - the transaction.
+//   2. `query` - the query. Its Limit and Direction are not used.
+//
+// It shall returns:
+//   1. count, hestiaError.OK | `0` - operation successful.
+//   2. `0`, hestiaError.EOWNERDEAD - given `tx` is unusable.
+//   3. `0`, hestiaError.ENOMEDIUM - given `query` is `nil`.
+//   4. `0`, hestiaError.ENOENT - the store or index does not exist.
+//   5. `0`, hestiaError.EINVAL - given `query` has an invalid key range.
+//   6. `0`, hestiaError.EBADFD - given `tx` is already finished.
+func IDBCount(tx *IDBTransaction, query *IDBQuery) (uint64, hestiaError.Error) {
+	var err hestiaError.Error
+
+	err = __checkIDBQuery(tx, query)
+	if err != hestiaError.OK {
+		return 0, err
+	}
+
+	return _idbCount(tx, query)
+}
+
+// IDBCreateIndex creates an index in an object store.
+//
+// It accepts the following parameters:
+//   1. `upgrade` - the IDBUpgrade from IDB.OnUpgrade.
+//   2. `store` - the object store name.
+//   3. `options` - the index configurations.
+//
+// It shall returns:
+//   1. hestiaError.OK | `0` - operation successful.
+//   2. hestiaError.EOWNERDEAD - given `upgrade` is unusable.
+//   3. hestiaError.ENOMEDIUM - given `options` is `nil` or has no Name.
+//   4. hestiaError.ENOENT - given `store` does not exist.
+//   5. hestiaError.EEXIST - the index already exists.
+func IDBCreateIndex(upgrade *IDBUpgrade, store string, options *IDBIndexOptions) hestiaError.Error {
+	if upgrade == nil || upgrade.handle == nil {
+		return hestiaError.EOWNERDEAD
+	}
+
+	if options == nil || options.Name == "" {
+		return hestiaError.ENOMEDIUM
+	}
+
+	return _idbCreateIndex(upgrade, store, options)
+}
+
+// IDBCreateStore creates an object store.
+//
+// It accepts the following parameters:
+//   1. `upgrade` - the IDBUpgrade from IDB.OnUpgrade.
+//   2. `options` - the object store configurations.
+//
+// It shall returns:
+//   1. hestiaError.OK | `0` - operation successful.
+//   2. hestiaError.EOWNERDEAD - given `upgrade` is unusable.
+//   3. hestiaError.ENOMEDIUM - given `options` is `nil` or has no Name.
+//   4. hestiaError.EEXIST - the object store already exists.
+func IDBCreateStore(upgrade *IDBUpgrade, options *IDBStoreOptions) hestiaError.Error {
+	if upgrade == nil || upgrade.handle == nil {
+		return hestiaError.EOWNERDEAD
+	}
+
+	if options == nil || options.Name == "" {
+		return hestiaError.ENOMEDIUM
+	}
+
+	return _idbCreateStore(upgrade, options)
+}
+
+// IDBCursorContinue advances a cursor to the next record.
+//
+// It accepts the following parameters:
+//   1. `cursor` - the cursor.
+//
+// It shall returns:
+//   1. hestiaError.OK | `0` - operation successful.
+//   2. hestiaError.EOWNERDEAD - given `cursor` is unusable.
+//   3. hestiaError.ENODATA - no more records.
+//   4. hestiaError.EBADFD - the transaction is already finished.
+func IDBCursorContinue(cursor *IDBCursor) hestiaError.Error {
+	if cursor == nil || cursor.handle == nil {
+		return hestiaError.EOWNERDEAD
+	}
+
+	return _idbCursorContinue(cursor)
+}
+
+// IDBCursorDelete deletes the record at a cursor.
+//
+// It accepts the following parameters:
+//   1. `cursor` - the cursor from an IDB_MODE_READWRITE transaction.
+//
+// It shall returns:
+//   1. hestiaError.OK | `0` - operation successful.
+//   2. hestiaError.EOWNERDEAD - given `cursor` is unusable.
+//   3. hestiaError.EROFS - the transaction is IDB_MODE_READONLY.
+//   4. hestiaError.EBADFD - the transaction is already finished.
+func IDBCursorDelete(cursor *IDBCursor) hestiaError.Error {
+	if cursor == nil || cursor.handle == nil {
+		return hestiaError.EOWNERDEAD
+	}
+
+	return _idbCursorDelete(cursor)
+}
+
+// IDBCursorUpdate replaces the record at a cursor.
+//
+// It accepts the following parameters:
+//   1. `cursor` - the cursor from an IDB_MODE_READWRITE transaction.
+//   2. `value` - the Go value to write. Its in-line key **MUST** be
+//                unchanged.
+//
+// It shall returns:
+//   1. hestiaError.OK | `0` - operation successful.
+//   2. hestiaError.EOWNERDEAD - given `cursor` is unusable.
+//   3. hestiaError.EILSEQ - given `value` cannot be encoded.
+//   4. hestiaError.EINVAL - given `value` changed its in-line key.
+//   5. hestiaError.EEXIST - a unique index key exists.
+//   6. hestiaError.EROFS - the transaction is IDB_MODE_READONLY.
+//   7. hestiaError.EBADFD - the transaction is already finished.
+func IDBCursorUpdate(cursor *IDBCursor, value any) hestiaError.Error {
+	var data any
+	var err hestiaError.Error
+
+	if cursor == nil || cursor.handle == nil {
+		return hestiaError.EOWNERDEAD
+	}
+
+	data, err = __encodeValue(reflect.ValueOf(value), convert_DEPTH_DEFAULT)
+	if err != hestiaError.OK {
+		return hestiaError.EILSEQ
+	}
+
+	return _idbCursorUpdate(cursor, data)
+}
+
+// IDBDecode fills a Go format record into a Go data structure.
+//
+// It follows the same rules as `Decode(...)` and is meant for IDBCursor.Value.
+//
+// It accepts the following parameters:
+//   1. `value` - the Go format record.
+//   2. `out` - the pointer to the Go data structure to fill.
+//
+// It shall returns:
+//   1. hestiaError.OK | `0` - operation successful.
+//   2. hestiaError.EINVAL - given `out` is `nil` or not a pointer.
+//   3. hestiaError.EPROTOTYPE - a value type is mismatched with its Go field
+//                               type.
+//   4. hestiaError.ERANGE - a number overflows its Go field type.
+func IDBDecode(value any, out any) hestiaError.Error {
+	var target reflect.Value
+
+	if out == nil {
+		return hestiaError.EINVAL
+	}
+
+	target = reflect.ValueOf(out)
+	if target.Kind() != reflect.Pointer || target.IsNil() {
+		return hestiaError.EINVAL
+	}
+
+	return __decodeValue(target.Elem(), value)
+}
+
+// IDBDelete deletes the records matching a key range.
+//
+// It accepts the following parameters:
+//   1. `tx` - the IDB_MODE_READWRITE transaction.
+//   2. `store` - the object store name.
+//   3. `keys` - the key range to delete.
+//
+// It shall returns:
+//   1. hestiaError.OK | `0` - operation successful.
+//   2. hestiaError.EOWNERDEAD - given `tx` is unusable.
+//   3. hestiaError.ENOMEDIUM - given `keys` is `nil`.
+//   4. hestiaError.ENOENT - given `store` is not in the transaction.
+//   5. hestiaError.EINVAL - given `keys` is invalid.
+//   6. hestiaError.EROFS - given `tx` is IDB_MODE_READONLY.
+//   7. hestiaError.EBADFD - given `tx` is already finished.
+func IDBDelete(tx *IDBTransaction, store string, keys *IDBKeyRange) hestiaError.Error {
+	if tx == nil || tx.handle == nil {
+		return hestiaError.EOWNERDEAD
+	}
+
+	if keys == nil {
+		return hestiaError.ENOMEDIUM
+	}
+
+	return _idbDelete(tx, store, keys)
+}
+
+// IDBDeleteDatabase deletes an IndexedDB database.
+//
+// It blocks until all other connections are closed.
+//
+// It accepts the following parameters:
+//   1. `name` - the database name.
+//
+// It shall returns:
+//   1. hestiaError.OK | `0` - operation successful.
+//   2. hestiaError.ENOMEDIUM - given `name` is empty.
+//   3. hestiaError.EACCES - IndexedDB is disabled or inaccessible.
+func IDBDeleteDatabase(name string) hestiaError.Error {
+	if name == "" {
+		return hestiaError.ENOMEDIUM
+	}
+
+	return _idbDeleteDatabase(name)
+}
+
+// IDBDeleteIndex deletes an index from an object store.
+//
+// It accepts the following parameters:
+//   1. `upgrade` - the IDBUpgrade from IDB.OnUpgrade.
+//   2. `store` - the object store name.
+//   3. `name` - the index name.
+//
+// It shall returns:
+//   1. hestiaError.OK | `0` - operation successful.
+//   2. hestiaError.EOWNERDEAD - given `upgrade` is unusable.
+//   3. hestiaError.ENOENT - given `store` or `name` does not exist.
+func IDBDeleteIndex(upgrade *IDBUpgrade, store string, name string) hestiaError.Error {
+	if upgrade == nil || upgrade.handle == nil {
+		return hestiaError.EOWNERDEAD
+	}
+
+	return _idbDeleteIndex(upgrade, store, name)
+}
+
+// IDBDeleteStore deletes an object store with all its records.
+//
+// It accepts the following parameters:
+//   1. `upgrade` - the IDBUpgrade from IDB.OnUpgrade.
+//   2. `name` - the object store name.
+//
+// It shall returns:
+//   1. hestiaError.OK | `0` - operation successful.
+//   2. hestiaError.EOWNERDEAD - given `upgrade` is unusable.
+//   3. hestiaError.ENOENT - given `name` does not exist.
+func IDBDeleteStore(upgrade *IDBUpgrade, name string) hestiaError.Error {
+	if upgrade == nil || upgrade.handle == nil {
+		return hestiaError.EOWNERDEAD
+	}
+
+	return _idbDeleteStore(upgrade, name)
+}
+
+// IDBGet reads a record by its key and fills it into a Go data structure.
+//
+// It accepts the following parameters:
+//   1. `tx` - the transaction.
+//   2. `store` - the object store name.
+//   3. `key` - the record key.
+//   4. `out` - the pointer to the Go data structure to fill.
+//
+// It shall returns:
+//   1. hestiaError.OK | `0` - operation successful.
+//   2. hestiaError.EOWNERDEAD - given `tx` is unusable.
+//   3. hestiaError.ENOENT - given `store` is not in the transaction.
+//   4. hestiaError.EINVAL - given `key` or `out` is invalid.
+//   5. hestiaError.ENODATA - given `key` does not exist.
+//   6. hestiaError.EBADFD - given `tx` is already finished.
+//   7. Any error from `IDBDecode(...)`.
+func IDBGet(tx *IDBTransaction, store string, key any, out any) hestiaError.Error {
+	var value any
+	var err hestiaError.Error
+
+	if tx == nil || tx.handle == nil {
+		return hestiaError.EOWNERDEAD
+	}
+
+	key, err = __idbKey(key)
+	if err != hestiaError.OK {
+		return err
+	}
+
+	value, err = _idbGet(tx, store, key)
+	if err != hestiaError.OK {
+		return err
+	}
+
+	return IDBDecode(value, out)
+}
+
+// IDBGetAll reads the records matching a query and fills them into a slice.
+//
+// It accepts the following parameters:
+//   1. `tx` - the transaction.
+//   2. `query` - the query.
+//   3. `out` - the pointer to the Go slice to fill.
+//
+// It shall returns:
+//   1. hestiaError.OK | `0` - operation successful.
+//   2. hestiaError.EOWNERDEAD - given `tx` is unusable.
+//   3. hestiaError.ENOMEDIUM - given `query` is `nil`.
+//   4. hestiaError.ENOENT - the store or index does not exist.
+//   5. hestiaError.EINVAL - given `query` or `out` is invalid.
+//   6. hestiaError.EBADFD - given `tx` is already finished.
+//   7. Any error from `IDBDecode(...)`.
+func IDBGetAll(tx *IDBTransaction, query *IDBQuery, out any) hestiaError.Error {
+	var list []any
+	var err hestiaError.Error
+
+	err = __checkIDBQuery(tx, query)
+	if err != hestiaError.OK {
+		return err
+	}
+
+	list, err = _idbGetAll(tx, query)
+	if err != hestiaError.OK {
+		return err
+	}
+
+	return IDBDecode(list, out)
+}
+
+// IDBOpen opens an IndexedDB database.
+//
+// It blocks until the database is opened including running IDB.OnUpgrade and
+// waiting for other pages holding an older version.
+//
+// It accepts the following parameters:
+//   1. `db` - the IDB.
+//
+// It shall returns:
+//   1. hestiaError.OK | `0` - operation successful.
+//   2. hestiaError.EOWNERDEAD - given `db` is `nil`.
+//   3. hestiaError.ENOMEDIUM - given `db` has no Name.
+//   4. hestiaError.EALREADY - given `db` is already opened.
+//   5. hestiaError.ESTALE - given `db` has an older Version than the existing
+//                           database.
+//   6. hestiaError.ECANCELED - IDB.OnUpgrade failed.
+//   7. hestiaError.EACCES - IndexedDB is disabled or inaccessible.
+func IDBOpen(db *IDB) hestiaError.Error {
+	var err hestiaError.Error
+
+	if db == nil {
+		return hestiaError.EOWNERDEAD
+	}
+
+	if db.Name == "" {
+		return hestiaError.ENOMEDIUM
+	}
+
+	db.mutex.Lock()
+	defer db.mutex.Unlock()
+
+	if db.handle != nil {
+		return hestiaError.EALREADY
+	}
+
+	if db.Version == 0 {
+		db.Version = idb_VERSION_DEFAULT
+	}
+
+	db.handle, err = _idbOpen(db)
+
+	return err
+}
+
+// IDBOpenCursor opens a cursor positioned at the first record of a query.
+//
+// It accepts the following parameters:
+//   1. `tx` - the transaction.
+//   2. `query` - the query. Its Limit is not used.
+//
+// It shall returns:
+//   1. IDBCursor, hestiaError.OK | `0` - operation successful.
+//   2. `nil`, hestiaError.EOWNERDEAD - given `tx` is unusable.
+//   3. `nil`, hestiaError.ENOMEDIUM - given `query` is `nil`.
+//   4. `nil`, hestiaError.ENOENT - the store or index does not exist.
+//   5. `nil`, hestiaError.EINVAL - given `query` is invalid.
+//   6. `nil`, hestiaError.ENODATA - no record matches the query.
+//   7. `nil`, hestiaError.EBADFD - given `tx` is already finished.
+func IDBOpenCursor(tx *IDBTransaction, query *IDBQuery) (*IDBCursor, hestiaError.Error) {
+	var err hestiaError.Error
+
+	err = __checkIDBQuery(tx, query)
+	if err != hestiaError.OK {
+		return nil, err
+	}
+
+	return _idbOpenCursor(tx, query)
+}
+
+// IDBPut writes a record into an object store, replacing any existing one.
+//
+// Like Javascript, a failed operation aborts the whole transaction.
+//
+// It accepts the following parameters:
+//   1. `tx` - the IDB_MODE_READWRITE transaction.
+//   2. `store` - the object store name.
+//   3. `value` - the Go value to write.
+//   4. `key` - the out-of-line key. It **MUST** be `nil` for stores with
+//              KeyPath or to auto-increment.
+//
+// It shall returns:
+//   1. key, hestiaError.OK | `0` - operation successful.
+//   2. `nil`, hestiaError.EOWNERDEAD - given `tx` is unusable.
+//   3. `nil`, hestiaError.ENOENT - given `store` is not in the transaction.
+//   4. `nil`, hestiaError.EILSEQ - given `value` cannot be encoded.
+//   5. `nil`, hestiaError.EINVAL - given `key` is invalid or missing.
+//   6. `nil`, hestiaError.EEXIST - a unique index key exists.
+//   7. `nil`, hestiaError.EROFS - given `tx` is IDB_MODE_READONLY.
+//   8. `nil`, hestiaError.ENOSPC - the storage quota is exceeded.
+//   9. `nil`, hestiaError.EBADFD - given `tx` is already finished.
+func IDBPut(tx *IDBTransaction, store string, value any, key any) (any, hestiaError.Error) {
+	return __idbWrite(tx, store, value, key, false)
+}
+
+// IDBTransact runs a function inside an IndexedDB transaction.
+//
+// Like Javascript, the transaction is committed automatically once `fx`
+// returns and all its operations are done. Hence, `fx` **SHALL NOT** wait for
+// anything other than the IDB operations (e.g. network or timers) or the
+// transaction becomes inactive.
+//
+// Transactions with overlapping `stores` run one after another when either is
+// IDB_MODE_READWRITE and an upgrading `IDBOpen(...)` waits for all of them.
+// Hence, `fx` **SHALL NOT** start such transaction or upgrade by itself.
+//
+// It accepts the following parameters:
+//   1. `db` - the opened IDB.
+//   2. `stores` - the object store names used in the transaction.
+//   3. `mode` - the transaction mode.
+//   4. `fx` - the function using the transaction. Returning an error aborts
+//             the transaction.
+//
+// It shall returns:
+//   1. hestiaError.OK | `0` - transaction committed.
+//   2. hestiaError.EOWNERDEAD - given `db` is `nil`.
+//   3. hestiaError.ENOTCONN - given `db` is not opened.
+//   4. hestiaError.ENOMEDIUM - given `stores` is empty or `fx` is `nil`.
+//   5. hestiaError.EINVAL - given `mode` is unknown.
+//   6. hestiaError.ENOENT - one of the `stores` does not exist.
+//   7. The error returned by `fx` or the error aborting the transaction.
+func IDBTransact(db *IDB,
+	stores []string,
+	mode IDBMode,
+	fx func(tx *IDBTransaction) hestiaError.Error) hestiaError.Error {
+	var handle *idbHandle
+
+	if db == nil {
+		return hestiaError.EOWNERDEAD
+	}
+
+	if len(stores) == 0 || fx == nil {
+		return hestiaError.ENOMEDIUM
+	}
+
+	if mode >= idb_MODE_MAX {
+		return hestiaError.EINVAL
+	}
+
+	db.mutex.Lock()
+	handle = db.handle
+	db.mutex.Unlock()
+
+	if handle == nil {
+		return hestiaError.ENOTCONN
+	}
+
+	return _idbTransact(handle, stores, mode, fx)
+}
+
+// NOTE: all functions below are sub-functions. Please use the global version
+// since it has proper guarding like `nil` object checking.
+
+func __checkIDBQuery(tx *IDBTransaction, query *IDBQuery) hestiaError.Error {
+	if tx == nil || tx.handle == nil {
+		return hestiaError.EOWNERDEAD
+	}
+
+	if query == nil {
+		return hestiaError.ENOMEDIUM
+	}
+
+	if query.Direction >= idb_DIRECTION_MAX {
+		return hestiaError.EINVAL
+	}
+
+	return hestiaError.OK
+}
+
+func __idbCompare(a any, b any) int {
+	var x, y []any
+	var i, ret int
+
+	// numbers < strings < arrays
+	ret = __idbKeyType(a) - __idbKeyType(b)
+	if ret != 0 {
+		return ret
+	}
+
+	switch v := a.(type) {
+	case float64:
+		switch {
+		case v < b.(float64):
+			return -1
+		case v > b.(float64):
+			return 1
+		}
+	case string:
+		switch {
+		case v < b.(string):
+			return -1
+		case v > b.(string):
+			return 1
+		}
+	case []any:
+		x = v
+		y = b.([]any)
+
+		for i = 0; i < len(x) && i < len(y); i++ {
+			ret = __idbCompare(x[i], y[i])
+			if ret != 0 {
+				return ret
+			}
+		}
+
+		return len(x) - len(y)
+	}
+
+	return 0
+}
+
+func __idbKey(key any) (out any, err hestiaError.Error) {
+	out, err = __encodeValue(reflect.ValueOf(key), convert_DEPTH_DEFAULT)
+	if err != hestiaError.OK {
+		return nil, hestiaError.EINVAL
+	}
+
+	out = __idbNormalize(out)
+	if !__idbKeyValid(out) {
+		return nil, hestiaError.EINVAL
+	}
+
+	return out, hestiaError.OK
+}
+
+func __idbKeyRange(keys *IDBKeyRange) (lower any, upper any, err hestiaError.Error) {
+	if keys.Lower != nil {
+		lower, err = __idbKey(keys.Lower)
+		if err != hestiaError.OK {
+			return nil, nil, err
+		}
+	}
+
+	if keys.Upper != nil {
+		upper, err = __idbKey(keys.Upper)
+		if err != hestiaError.OK {
+			return nil, nil, err
+		}
+	}
+
+	if lower != nil && upper != nil {
+		switch ret := __idbCompare(lower, upper); {
+		case ret > 0:
+			return nil, nil, hestiaError.EINVAL
+		case ret == 0 && (keys.LowerOpen || keys.UpperOpen):
+			return nil, nil, hestiaError.EINVAL
+		}
+	}
+
+	return lower, upper, hestiaError.OK
+}
+
+func __idbKeyType(key any) int {
+	switch key.(type) {
+	case float64:
+		return 1
+	case string:
+		return 2
+	case []any:
+		return 3
+	default:
+		return 0
+	}
+}
+
+func __idbKeyValid(key any) bool {
+	var item any
+
+	switch v := key.(type) {
+	case float64, string:
+		return true
+	case []any:
+		for _, item = range v {
+			if !__idbKeyValid(item) {
+				return false
+			}
+		}
+
+		return true
+	default:
+		return false
+	}
+}
+
+func __idbNormalize(value any) any {
+	var list map[string]any
+	var array []any
+	var key string
+	var i int
+
+	// numbers are always float64 like Javascript
+	switch v := value.(type) {
+	case int64:
+		return float64(v)
+	case uint64:
+		return float64(v)
+	case []any:
+		array = make([]any, len(v))
+		for i = range v {
+			array[i] = __idbNormalize(v[i])
+		}
+
+		return array
+	case map[string]any:
+		list = make(map[string]any, len(v))
+		for key = range v {
+			list[key] = __idbNormalize(v[key])
+		}
+
+		return list
+	default:
+		return value
+	}
+}
+
+func __idbWrite(tx *IDBTransaction,
+	store string,
+	value any,
+	key any,
+	isAdd bool) (any, hestiaError.Error) {
+	var data any
+	var err hestiaError.Error
+
+	if tx == nil || tx.handle == nil {
+		return nil, hestiaError.EOWNERDEAD
+	}
+
+	if key != nil {
+		key, err = __idbKey(key)
+		if err != hestiaError.OK {
+			return nil, err
+		}
+	}
+
+	data, err = __encodeValue(reflect.ValueOf(value), convert_DEPTH_DEFAULT)
+	if err != hestiaError.OK {
+		return nil, hestiaError.EILSEQ
+	}
+
+	return _idbPut(tx, store, data, key, isAdd)
+}
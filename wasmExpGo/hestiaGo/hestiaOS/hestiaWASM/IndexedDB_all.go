@@ -0,0 +1,999 @@
+// Copyright 2022 "Holloway" Chew, Kean Ho <hollowaykeanho@gmail.com>
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+//go:build !wasm
+// +build !wasm
+
+package hestiaWASM
+
+import (
+	"hestiaGo/hestiaError"
+	"math"
+	"sort"
+	"strings"
+	"sync"
+)
+
+type idbHandle struct {
+	db     *idbMemory
+	owner  *IDB
+	closed bool
+}
+
+type idbUpgradeHandle struct {
+	db *idbMemory
+}
+
+type idbTxHandle struct {
+	stores map[string]*idbMemoryStore
+	failed hestiaError.Error
+	mode   IDBMode
+	done   bool
+}
+
+type idbCursorHandle struct {
+	tx      *IDBTransaction
+	store   string
+	entries []*idbEntry
+	index   int
+}
+
+// idbMemory is a database where `mutex` only guards its fields briefly while
+// `schedule` and `locks` queue the transactions like Javascript: overlapping
+// IDB_MODE_READWRITE scopes run one after another and upgrades wait for all.
+type idbMemory struct {
+	stores   map[string]*idbMemoryStore
+	locks    map[string]*sync.RWMutex
+	handles  []*idbHandle
+	version  uint64
+	schedule sync.RWMutex
+	mutex    sync.Mutex
+}
+
+type idbMemoryStore struct {
+	records       []*idbRecord
+	indexes       map[string]*IDBIndexOptions
+	keyPath       string
+	counter       float64
+	autoIncrement bool
+}
+
+type idbRecord struct {
+	key   any
+	value any
+}
+
+type idbEntry struct {
+	key        any
+	primaryKey any
+	value      any
+}
+
+var idbDatabases = struct {
+	list  map[string]*idbMemory
+	mutex sync.Mutex
+}{
+	list: map[string]*idbMemory{},
+}
+
+func _idbClear(tx *IDBTransaction, name string) hestiaError.Error {
+	var store *idbMemoryStore
+	var err hestiaError.Error
+
+	store, err = __idbScope(tx, name, true)
+	if err != hestiaError.OK {
+		return err
+	}
+
+	store.records = nil
+
+	return hestiaError.OK
+}
+
+func _idbClose(db *IDB) {
+	var mem *idbMemory
+	var i int
+
+	mem = db.handle.db
+
+	mem.mutex.Lock()
+	defer mem.mutex.Unlock()
+
+	db.handle.closed = true
+	for i = range mem.handles {
+		if mem.handles[i] != db.handle {
+			continue
+		}
+
+		mem.handles = append(mem.handles[:i], mem.handles[i+1:]...)
+
+		return
+	}
+}
+
+func _idbCount(tx *IDBTransaction, query *IDBQuery) (uint64, hestiaError.Error) {
+	var entries []*idbEntry
+	var err hestiaError.Error
+
+	entries, err = __idbQuery(tx, query)
+	if err != hestiaError.OK {
+		return 0, err
+	}
+
+	return uint64(len(entries)), hestiaError.OK
+}
+
+func _idbCreateIndex(upgrade *IDBUpgrade, name string, options *IDBIndexOptions) hestiaError.Error {
+	var store *idbMemoryStore
+	var index IDBIndexOptions
+	var ok bool
+
+	store, ok = upgrade.handle.db.stores[name]
+	if !ok {
+		return hestiaError.ENOENT
+	}
+
+	_, ok = store.indexes[options.Name]
+	if ok {
+		return hestiaError.EEXIST
+	}
+
+	index = *options
+	if index.Unique && __idbDuplicated(__idbIndexEntries(store, &index)) {
+		return hestiaError.EEXIST
+	}
+
+	store.indexes[options.Name] = &index
+
+	return hestiaError.OK
+}
+
+func _idbCreateStore(upgrade *IDBUpgrade, options *IDBStoreOptions) hestiaError.Error {
+	var ok bool
+
+	_, ok = upgrade.handle.db.stores[options.Name]
+	if ok {
+		return hestiaError.EEXIST
+	}
+
+	upgrade.handle.db.stores[options.Name] = &idbMemoryStore{
+		indexes:       map[string]*IDBIndexOptions{},
+		keyPath:       options.KeyPath,
+		autoIncrement: options.AutoIncrement,
+	}
+
+	return hestiaError.OK
+}
+
+func _idbCursorContinue(cursor *IDBCursor) hestiaError.Error {
+	var handle *idbCursorHandle
+
+	handle = cursor.handle
+	if handle.tx.handle.done {
+		return hestiaError.EBADFD
+	}
+
+	handle.index++
+	if handle.index >= len(handle.entries) {
+		handle.index = len(handle.entries)
+		return hestiaError.ENODATA
+	}
+
+	__idbCursorFill(cursor)
+
+	return hestiaError.OK
+}
+
+func _idbCursorDelete(cursor *IDBCursor) hestiaError.Error {
+	var store *idbMemoryStore
+	var err hestiaError.Error
+
+	store, err = __idbScope(cursor.handle.tx, cursor.handle.store, true)
+	if err != hestiaError.OK {
+		return err
+	}
+
+	__idbRemove(store, &idbEntry{
+		key:        cursor.PrimaryKey,
+		primaryKey: cursor.PrimaryKey,
+	}, nil, nil)
+
+	return hestiaError.OK
+}
+
+func _idbCursorUpdate(cursor *IDBCursor, value any) hestiaError.Error {
+	var store *idbMemoryStore
+	var key any
+	var ok bool
+	var err hestiaError.Error
+
+	store, err = __idbScope(cursor.handle.tx, cursor.handle.store, true)
+	if err != hestiaError.OK {
+		return err
+	}
+
+	if store.keyPath == "" {
+		_, err = _idbPut(cursor.handle.tx,
+			cursor.handle.store,
+			value,
+			cursor.PrimaryKey,
+			false,
+		)
+
+		return err
+	}
+
+	key, ok = __idbPath(__idbNormalize(value), store.keyPath)
+	if !ok || !__idbKeyValid(key) || __idbCompare(key, cursor.PrimaryKey) != 0 {
+		return hestiaError.EINVAL
+	}
+
+	_, err = _idbPut(cursor.handle.tx, cursor.handle.store, value, nil, false)
+
+	return err
+}
+
+func _idbDelete(tx *IDBTransaction, name string, keys *IDBKeyRange) hestiaError.Error {
+	var store *idbMemoryStore
+	var lower, upper any
+	var err hestiaError.Error
+
+	store, err = __idbScope(tx, name, true)
+	if err != hestiaError.OK {
+		return err
+	}
+
+	lower, upper, err = __idbKeyRange(keys)
+	if err != hestiaError.OK {
+		return err
+	}
+
+	__idbRemove(store, nil, keys, []any{lower, upper})
+
+	return hestiaError.OK
+}
+
+func _idbDeleteDatabase(name string) hestiaError.Error {
+	var mem *idbMemory
+	var handle *idbHandle
+
+	idbDatabases.mutex.Lock()
+	mem = idbDatabases.list[name]
+	delete(idbDatabases.list, name)
+	idbDatabases.mutex.Unlock()
+
+	if mem == nil {
+		return hestiaError.OK
+	}
+
+	mem.mutex.Lock()
+	defer mem.mutex.Unlock()
+
+	for _, handle = range mem.handles {
+		handle.closed = true
+	}
+	mem.handles = nil
+
+	return hestiaError.OK
+}
+
+func _idbDeleteIndex(upgrade *IDBUpgrade, name string, index string) hestiaError.Error {
+	var store *idbMemoryStore
+	var ok bool
+
+	store, ok = upgrade.handle.db.stores[name]
+	if !ok {
+		return hestiaError.ENOENT
+	}
+
+	_, ok = store.indexes[index]
+	if !ok {
+		return hestiaError.ENOENT
+	}
+
+	delete(store.indexes, index)
+
+	return hestiaError.OK
+}
+
+func _idbDeleteStore(upgrade *IDBUpgrade, name string) hestiaError.Error {
+	var ok bool
+
+	_, ok = upgrade.handle.db.stores[name]
+	if !ok {
+		return hestiaError.ENOENT
+	}
+
+	delete(upgrade.handle.db.stores, name)
+
+	return hestiaError.OK
+}
+
+func _idbGet(tx *IDBTransaction, name string, key any) (any, hestiaError.Error) {
+	var store *idbMemoryStore
+	var i int
+	var ok bool
+	var err hestiaError.Error
+
+	store, err = __idbScope(tx, name, false)
+	if err != hestiaError.OK {
+		return nil, err
+	}
+
+	i, ok = __idbSearch(store, key)
+	if !ok {
+		return nil, hestiaError.ENODATA
+	}
+
+	return __idbNormalize(store.records[i].value), hestiaError.OK
+}
+
+func _idbGetAll(tx *IDBTransaction, query *IDBQuery) ([]any, hestiaError.Error) {
+	var entries []*idbEntry
+	var list []any
+	var i int
+	var err hestiaError.Error
+
+	entries, err = __idbQuery(tx, query)
+	if err != hestiaError.OK {
+		return nil, err
+	}
+
+	if query.Limit > 0 && uint(len(entries)) > query.Limit {
+		entries = entries[:query.Limit]
+	}
+
+	list = make([]any, len(entries))
+	for i = range entries {
+		list[i] = __idbNormalize(entries[i].value)
+	}
+
+	return list, hestiaError.OK
+}
+
+func _idbOpen(db *IDB) (*idbHandle, hestiaError.Error) {
+	var mem *idbMemory
+	var handle *idbHandle
+	var snapshot map[string]*idbMemoryStore
+	var upgrade *IDBUpgrade
+	var ok bool
+	var err hestiaError.Error
+
+	idbDatabases.mutex.Lock()
+	mem, ok = idbDatabases.list[db.Name]
+	if !ok {
+		mem = &idbMemory{
+			stores: map[string]*idbMemoryStore{},
+		}
+		idbDatabases.list[db.Name] = mem
+	}
+	idbDatabases.mutex.Unlock()
+
+	mem.mutex.Lock()
+	if db.Version > mem.version {
+		// wait for the running transactions before upgrading
+		mem.mutex.Unlock()
+		mem.schedule.Lock()
+		defer mem.schedule.Unlock()
+		mem.mutex.Lock()
+	}
+	defer mem.mutex.Unlock()
+
+	if db.Version < mem.version {
+		return nil, hestiaError.ESTALE
+	}
+
+	if db.Version > mem.version {
+		// ask the other connections to step aside like Javascript
+		for _, handle = range mem.handles {
+			if handle.owner.OnVersionChange != nil {
+				go handle.owner.OnVersionChange()
+				continue
+			}
+
+			handle.closed = true
+		}
+
+		snapshot = __idbSnapshot(mem.stores)
+		upgrade = &IDBUpgrade{
+			handle:     &idbUpgradeHandle{db: mem},
+			OldVersion: mem.version,
+			NewVersion: db.Version,
+		}
+
+		if db.OnUpgrade != nil {
+			err = db.OnUpgrade(upgrade)
+		}
+		upgrade.handle = nil
+
+		if err != hestiaError.OK {
+			mem.stores = snapshot
+			return nil, hestiaError.ECANCELED
+		}
+
+		mem.version = db.Version
+	}
+
+	handle = &idbHandle{
+		db:    mem,
+		owner: db,
+	}
+	mem.handles = append(mem.handles, handle)
+
+	return handle, hestiaError.OK
+}
+
+func _idbOpenCursor(tx *IDBTransaction, query *IDBQuery) (*IDBCursor, hestiaError.Error) {
+	var cursor *IDBCursor
+	var entries []*idbEntry
+	var err hestiaError.Error
+
+	entries, err = __idbQuery(tx, query)
+	if err != hestiaError.OK {
+		return nil, err
+	}
+
+	if len(entries) == 0 {
+		return nil, hestiaError.ENODATA
+	}
+
+	cursor = &IDBCursor{
+		handle: &idbCursorHandle{
+			tx:      tx,
+			store:   query.Store,
+			entries: entries,
+		},
+	}
+	__idbCursorFill(cursor)
+
+	return cursor, hestiaError.OK
+}
+
+func _idbPut(tx *IDBTransaction,
+	name string,
+	value any,
+	key any,
+	isAdd bool) (any, hestiaError.Error) {
+	var store *idbMemoryStore
+	var record *idbRecord
+	var i int
+	var ok bool
+	var err hestiaError.Error
+
+	store, err = __idbScope(tx, name, true)
+	if err != hestiaError.OK {
+		return nil, err
+	}
+
+	value = __idbNormalize(value)
+
+	key, err = __idbPrimaryKey(store, value, key)
+	if err != hestiaError.OK {
+		return nil, err
+	}
+
+	record = &idbRecord{
+		key:   key,
+		value: value,
+	}
+
+	i, ok = __idbSearch(store, key)
+	if ok && isAdd {
+		return nil, __idbFail(tx, hestiaError.EEXIST)
+	}
+
+	if __idbViolated(store, record) {
+		return nil, __idbFail(tx, hestiaError.EEXIST)
+	}
+
+	// keep the generator ahead of explicit numeric keys
+	if number, isNumber := key.(float64); store.autoIncrement && isNumber {
+		if number >= store.counter {
+			store.counter = math.Floor(number)
+		}
+	}
+
+	switch {
+	case ok:
+		store.records[i] = record
+	default:
+		store.records = append(store.records, nil)
+		copy(store.records[i+1:], store.records[i:])
+		store.records[i] = record
+	}
+
+	return __idbNormalize(key), hestiaError.OK
+}
+
+func _idbTransact(handle *idbHandle,
+	stores []string,
+	mode IDBMode,
+	fx func(tx *IDBTransaction) hestiaError.Error) (err hestiaError.Error) {
+	var mem *idbMemory
+	var tx *IDBTransaction
+	var base map[string]*idbMemoryStore
+	var store *idbMemoryStore
+	var lock *sync.RWMutex
+	var locks []*sync.RWMutex
+	var names []string
+	var name string
+	var ok bool
+
+	mem = handle.db
+
+	// lock the scope in a sorted order to never deadlock each other
+	names = append([]string(nil), stores...)
+	sort.Strings(names)
+
+	mem.mutex.Lock()
+	if mem.locks == nil {
+		mem.locks = map[string]*sync.RWMutex{}
+	}
+
+	for _, name = range names {
+		lock, ok = mem.locks[name]
+		if !ok {
+			lock = &sync.RWMutex{}
+			mem.locks[name] = lock
+		}
+
+		if len(locks) == 0 || locks[len(locks)-1] != lock {
+			locks = append(locks, lock)
+		}
+	}
+	mem.mutex.Unlock()
+
+	// wait for the overlapping IDB_MODE_READWRITE transactions and upgrades
+	mem.schedule.RLock()
+	defer mem.schedule.RUnlock()
+
+	for _, lock = range locks {
+		if mode == IDB_MODE_READWRITE {
+			lock.Lock()
+			defer lock.Unlock()
+			continue
+		}
+
+		lock.RLock()
+		defer lock.RUnlock()
+	}
+
+	// fx works on its own copy of the scoped stores for aborting so the
+	// database is not locked while it runs (e.g. IDBClose(...)).
+	mem.mutex.Lock()
+	if handle.closed {
+		mem.mutex.Unlock()
+		return hestiaError.ENOTCONN
+	}
+
+	base = make(map[string]*idbMemoryStore, len(names))
+	for _, name = range names {
+		store, ok = mem.stores[name]
+		if !ok {
+			mem.mutex.Unlock()
+			return hestiaError.ENOENT
+		}
+
+		base[name] = store
+	}
+
+	tx = &IDBTransaction{
+		Mode: mode,
+		handle: &idbTxHandle{
+			stores: __idbSnapshot(base),
+			mode:   mode,
+		},
+	}
+	mem.mutex.Unlock()
+
+	err = fx(tx)
+	tx.handle.done = true
+
+	if err == hestiaError.OK {
+		err = tx.handle.failed
+	}
+
+	if err != hestiaError.OK || mode != IDB_MODE_READWRITE {
+		return err
+	}
+
+	mem.mutex.Lock()
+	for name, store = range tx.handle.stores {
+		mem.stores[name] = store
+	}
+	mem.mutex.Unlock()
+
+	return hestiaError.OK
+}
+
+// NOTE: all functions below are sub-functions. Please use the global version
+// since it has proper guarding like `nil` object checking.
+
+func __idbCursorFill(cursor *IDBCursor) {
+	var entry *idbEntry
+
+	entry = cursor.handle.entries[cursor.handle.index]
+	cursor.Key = __idbNormalize(entry.key)
+	cursor.PrimaryKey = __idbNormalize(entry.primaryKey)
+	cursor.Value = __idbNormalize(entry.value)
+}
+
+func __idbDuplicated(entries []*idbEntry) bool {
+	var i int
+
+	// entries are sorted by key
+	for i = 1; i < len(entries); i++ {
+		if __idbCompare(entries[i-1].key, entries[i].key) == 0 {
+			return true
+		}
+	}
+
+	return false
+}
+
+func __idbFail(tx *IDBTransaction, err hestiaError.Error) hestiaError.Error {
+	// a failed request aborts the transaction like Javascript
+	if tx.handle.failed == hestiaError.OK {
+		tx.handle.failed = err
+	}
+
+	return err
+}
+
+func __idbInRange(key any, keys *IDBKeyRange, bounds []any) bool {
+	var ret int
+
+	if keys == nil {
+		return true
+	}
+
+	if bounds[0] != nil {
+		ret = __idbCompare(key, bounds[0])
+		if ret < 0 || (ret == 0 && keys.LowerOpen) {
+			return false
+		}
+	}
+
+	if bounds[1] != nil {
+		ret = __idbCompare(key, bounds[1])
+		if ret > 0 || (ret == 0 && keys.UpperOpen) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func __idbIndexEntries(store *idbMemoryStore, index *IDBIndexOptions) []*idbEntry {
+	var entries []*idbEntry
+	var record *idbRecord
+	var key any
+
+	for _, record = range store.records {
+		for _, key = range __idbIndexKeys(record.value, index) {
+			entries = append(entries, &idbEntry{
+				key:        key,
+				primaryKey: record.key,
+				value:      record.value,
+			})
+		}
+	}
+
+	sort.SliceStable(entries, func(i, j int) bool {
+		var ret int
+
+		ret = __idbCompare(entries[i].key, entries[j].key)
+		if ret != 0 {
+			return ret < 0
+		}
+
+		return __idbCompare(entries[i].primaryKey, entries[j].primaryKey) < 0
+	})
+
+	return entries
+}
+
+func __idbIndexKeys(value any, index *IDBIndexOptions) (keys []any) {
+	var key, item any
+	var list []any
+	var i int
+	var ok bool
+
+	key, ok = __idbPath(value, index.KeyPath)
+	if !ok {
+		return nil
+	}
+
+	list, ok = key.([]any)
+	if !index.MultiEntry || !ok {
+		if !__idbKeyValid(key) {
+			return nil
+		}
+
+		return []any{key}
+	}
+
+	// each unique valid item is indexed separately
+	for _, item = range list {
+		if !__idbKeyValid(item) {
+			continue
+		}
+
+		for i = range keys {
+			if __idbCompare(keys[i], item) == 0 {
+				item = nil
+				break
+			}
+		}
+
+		if item != nil {
+			keys = append(keys, item)
+		}
+	}
+
+	return keys
+}
+
+func __idbPath(value any, path string) (any, bool) {
+	var list map[string]any
+	var name string
+	var ok bool
+
+	if path == "" {
+		return value, true
+	}
+
+	for _, name = range strings.Split(path, ".") {
+		list, ok = value.(map[string]any)
+		if !ok {
+			return nil, false
+		}
+
+		value, ok = list[name]
+		if !ok {
+			return nil, false
+		}
+	}
+
+	return value, true
+}
+
+func __idbPrimaryKey(store *idbMemoryStore, value any, key any) (any, hestiaError.Error) {
+	var list, parent map[string]any
+	var names []string
+	var inline any
+	var i int
+	var ok bool
+
+	if store.keyPath == "" {
+		switch {
+		case key != nil:
+			return key, hestiaError.OK
+		case store.autoIncrement:
+			store.counter++
+			return store.counter, hestiaError.OK
+		default:
+			return nil, hestiaError.EINVAL
+		}
+	}
+
+	if key != nil {
+		return nil, hestiaError.EINVAL
+	}
+
+	inline, ok = __idbPath(value, store.keyPath)
+	switch {
+	case ok && __idbKeyValid(inline):
+		return inline, hestiaError.OK
+	case ok || !store.autoIncrement:
+		return nil, hestiaError.EINVAL
+	}
+
+	// inject the generated key into the value like Javascript
+	parent, ok = value.(map[string]any)
+	if !ok {
+		return nil, hestiaError.EINVAL
+	}
+
+	names = strings.Split(store.keyPath, ".")
+	for i = 0; i < len(names)-1; i++ {
+		list, ok = parent[names[i]].(map[string]any)
+		if !ok {
+			if parent[names[i]] != nil {
+				return nil, hestiaError.EINVAL
+			}
+
+			list = map[string]any{}
+			parent[names[i]] = list
+		}
+
+		parent = list
+	}
+
+	store.counter++
+	parent[names[len(names)-1]] = store.counter
+
+	return store.counter, hestiaError.OK
+}
+
+func __idbQuery(tx *IDBTransaction, query *IDBQuery) ([]*idbEntry, hestiaError.Error) {
+	var store *idbMemoryStore
+	var index *IDBIndexOptions
+	var entries, out []*idbEntry
+	var entry *idbEntry
+	var bounds []any
+	var record *idbRecord
+	var ok bool
+	var err hestiaError.Error
+
+	store, err = __idbScope(tx, query.Store, false)
+	if err != hestiaError.OK {
+		return nil, err
+	}
+
+	bounds = []any{nil, nil}
+	if query.Range != nil {
+		bounds[0], bounds[1], err = __idbKeyRange(query.Range)
+		if err != hestiaError.OK {
+			return nil, err
+		}
+	}
+
+	if query.Index == "" {
+		for _, record = range store.records {
+			entries = append(entries, &idbEntry{
+				key:        record.key,
+				primaryKey: record.key,
+				value:      record.value,
+			})
+		}
+	} else {
+		index, ok = store.indexes[query.Index]
+		if !ok {
+			return nil, hestiaError.ENOENT
+		}
+
+		entries = __idbIndexEntries(store, index)
+	}
+
+	for _, entry = range entries {
+		if !__idbInRange(entry.key, query.Range, bounds) {
+			continue
+		}
+
+		// unique directions keep the lowest primary key of each key
+		if (query.Direction == IDB_DIRECTION_NEXT_UNIQUE ||
+			query.Direction == IDB_DIRECTION_PREV_UNIQUE) &&
+			len(out) > 0 &&
+			__idbCompare(out[len(out)-1].key, entry.key) == 0 {
+			continue
+		}
+
+		out = append(out, entry)
+	}
+
+	if query.Direction == IDB_DIRECTION_PREV ||
+		query.Direction == IDB_DIRECTION_PREV_UNIQUE {
+		for i, j := 0, len(out)-1; i < j; i, j = i+1, j-1 {
+			out[i], out[j] = out[j], out[i]
+		}
+	}
+
+	return out, hestiaError.OK
+}
+
+func __idbRemove(store *idbMemoryStore, entry *idbEntry, keys *IDBKeyRange, bounds []any) {
+	var records []*idbRecord
+	var record *idbRecord
+
+	for _, record = range store.records {
+		switch {
+		case entry != nil && __idbCompare(record.key, entry.primaryKey) == 0:
+			continue
+		case entry == nil && __idbInRange(record.key, keys, bounds):
+			continue
+		}
+
+		records = append(records, record)
+	}
+
+	store.records = records
+}
+
+func __idbScope(tx *IDBTransaction, name string, isWrite bool) (*idbMemoryStore, hestiaError.Error) {
+	var handle *idbTxHandle
+	var store *idbMemoryStore
+	var ok bool
+
+	handle = tx.handle
+	if handle.done || handle.failed != hestiaError.OK {
+		return nil, hestiaError.EBADFD
+	}
+
+	store, ok = handle.stores[name]
+	if !ok {
+		return nil, hestiaError.ENOENT
+	}
+
+	if isWrite && handle.mode != IDB_MODE_READWRITE {
+		return nil, hestiaError.EROFS
+	}
+
+	return store, hestiaError.OK
+}
+
+func __idbSearch(store *idbMemoryStore, key any) (int, bool) {
+	var i int
+
+	i = sort.Search(len(store.records), func(i int) bool {
+		return __idbCompare(store.records[i].key, key) >= 0
+	})
+
+	if i < len(store.records) && __idbCompare(store.records[i].key, key) == 0 {
+		return i, true
+	}
+
+	return i, false
+}
+
+func __idbSnapshot(stores map[string]*idbMemoryStore) map[string]*idbMemoryStore {
+	var out map[string]*idbMemoryStore
+	var store, clone *idbMemoryStore
+	var name, index string
+
+	out = make(map[string]*idbMemoryStore, len(stores))
+	for name, store = range stores {
+		clone = &idbMemoryStore{}
+		*clone = *store
+
+		clone.records = append([]*idbRecord(nil), store.records...)
+		clone.indexes = make(map[string]*IDBIndexOptions, len(store.indexes))
+		for index = range store.indexes {
+			clone.indexes[index] = store.indexes[index]
+		}
+
+		out[name] = clone
+	}
+
+	return out
+}
+
+func __idbViolated(store *idbMemoryStore, record *idbRecord) bool {
+	var index *IDBIndexOptions
+	var entry *idbEntry
+	var key any
+
+	for _, index = range store.indexes {
+		if !index.Unique {
+			continue
+		}
+
+		for _, key = range __idbIndexKeys(record.value, index) {
+			for _, entry = range __idbIndexEntries(store, index) {
+				if __idbCompare(entry.key, key) == 0 &&
+					__idbCompare(entry.primaryKey, record.key) != 0 {
+					return true
+				}
+			}
+		}
+	}
+
+	return false
+}
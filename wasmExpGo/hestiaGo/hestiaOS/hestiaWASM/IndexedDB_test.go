@@ -0,0 +1,488 @@
+// Copyright 2022 "Holloway" Chew, Kean Ho <hollowaykeanho@gmail.com>
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+//go:build !wasm
+// +build !wasm
+
+package hestiaWASM
+
+import (
+	"hestiaGo/hestiaError"
+	"reflect"
+	"sync"
+	"testing"
+)
+
+type idbTestUser struct {
+	Name string   `js:"name"`
+	Tags []string `js:"tags"`
+	ID   float64  `js:"id"`
+}
+
+func TestIDBUpgrade(t *testing.T) {
+	var db *IDB
+	var versions [][2]uint64
+	var name string
+	var err hestiaError.Error
+
+	db = __openIDBTest(t, "upgrade", 1, func(upgrade *IDBUpgrade) hestiaError.Error {
+		versions = append(versions,
+			[2]uint64{upgrade.OldVersion, upgrade.NewVersion},
+		)
+
+		_ = IDBCreateStore(upgrade, &IDBStoreOptions{Name: "kept"})
+		return IDBCreateStore(upgrade, &IDBStoreOptions{Name: "dropped"})
+	})
+	_ = IDBClose(db)
+	name = db.Name
+
+	// a failed upgrade is rolled back entirely
+	db = &IDB{
+		Name:    name,
+		Version: 2,
+		OnUpgrade: func(upgrade *IDBUpgrade) hestiaError.Error {
+			_ = IDBDeleteStore(upgrade, "kept")
+			return hestiaError.EINVAL
+		},
+	}
+
+	err = IDBOpen(db)
+	if err != hestiaError.ECANCELED {
+		t.Fatalf("IDBOpen(...) failed upgrade = %v, want ECANCELED", err)
+	}
+
+	db = __openIDBTest(t, "upgrade", 2, func(upgrade *IDBUpgrade) hestiaError.Error {
+		versions = append(versions,
+			[2]uint64{upgrade.OldVersion, upgrade.NewVersion},
+		)
+
+		return IDBDeleteStore(upgrade, "dropped")
+	})
+	defer IDBClose(db)
+
+	if !reflect.DeepEqual(versions, [][2]uint64{{0, 1}, {1, 2}}) {
+		t.Fatalf("upgraded versions = %v, want [[0 1] [1 2]]", versions)
+	}
+
+	err = IDBTransact(db, []string{"kept"}, IDB_MODE_READONLY, __idbNoop)
+	if err != hestiaError.OK {
+		t.Fatalf("IDBTransact(kept) = %v, want OK", err)
+	}
+
+	err = IDBTransact(db, []string{"dropped"}, IDB_MODE_READONLY, __idbNoop)
+	if err != hestiaError.ENOENT {
+		t.Fatalf("IDBTransact(dropped) = %v, want ENOENT", err)
+	}
+
+	err = IDBOpen(&IDB{Name: name, Version: 1})
+	if err != hestiaError.ESTALE {
+		t.Fatalf("IDBOpen(...) older version = %v, want ESTALE", err)
+	}
+}
+
+func TestIDBIndex(t *testing.T) {
+	var db *IDB
+	var users []idbTestUser
+	var count uint64
+	var err hestiaError.Error
+
+	db = __openIDBTest(t, "index", 1, __upgradeIDBTest)
+	defer IDBClose(db)
+
+	__addIDBTest(t, db, "carol", "bob", "alice")
+
+	err = IDBTransact(db, []string{"users"}, IDB_MODE_READONLY,
+		func(tx *IDBTransaction) hestiaError.Error {
+			err = IDBGetAll(tx, &IDBQuery{Store: "users", Index: "name"}, &users)
+			if err != hestiaError.OK {
+				return err
+			}
+
+			count, err = IDBCount(tx, &IDBQuery{
+				Store: "users",
+				Index: "tags",
+				Range: &IDBKeyRange{Lower: "all", Upper: "all"},
+			})
+
+			return err
+		},
+	)
+	if err != hestiaError.OK {
+		t.Fatalf("IDBTransact(...) = %v, want OK", err)
+	}
+
+	// the index orders by its key instead of the primary key
+	if len(users) != 3 || users[0].Name != "alice" ||
+		users[1].Name != "bob" || users[2].Name != "carol" {
+		t.Fatalf("IDBGetAll(name) = %+v, want alice, bob, carol", users)
+	}
+
+	if users[0].ID != 3 {
+		t.Fatalf("alice ID = %v, want auto-incremented 3", users[0].ID)
+	}
+
+	// the multi-entry index counts every tag
+	if count != 3 {
+		t.Fatalf("IDBCount(tags = all) = %d, want 3", count)
+	}
+
+	err = IDBTransact(db, []string{"users"}, IDB_MODE_READWRITE,
+		func(tx *IDBTransaction) hestiaError.Error {
+			_, err = IDBAdd(tx, "users", map[string]any{"name": "bob"}, nil)
+			return err
+		},
+	)
+	if err != hestiaError.EEXIST {
+		t.Fatalf("IDBAdd(...) duplicated unique key = %v, want EEXIST", err)
+	}
+}
+
+func TestIDBCursor(t *testing.T) {
+	var db *IDB
+	var cursor *IDBCursor
+	var names []string
+	var user idbTestUser
+	var err hestiaError.Error
+
+	db = __openIDBTest(t, "cursor", 1, __upgradeIDBTest)
+	defer IDBClose(db)
+
+	__addIDBTest(t, db, "a", "b", "c", "d")
+
+	err = IDBTransact(db, []string{"users"}, IDB_MODE_READWRITE,
+		func(tx *IDBTransaction) hestiaError.Error {
+			cursor, err = IDBOpenCursor(tx, &IDBQuery{
+				Store:     "users",
+				Direction: IDB_DIRECTION_PREV,
+			})
+
+			for err == hestiaError.OK {
+				err = IDBDecode(cursor.Value, &user)
+				if err != hestiaError.OK {
+					return err
+				}
+				names = append(names, user.Name)
+
+				switch user.Name {
+				case "c":
+					err = IDBCursorDelete(cursor)
+				case "b":
+					user.Name = "B"
+					err = IDBCursorUpdate(cursor, &user)
+				}
+
+				if err != hestiaError.OK {
+					return err
+				}
+
+				err = IDBCursorContinue(cursor)
+			}
+
+			if err != hestiaError.ENODATA {
+				return err
+			}
+
+			return hestiaError.OK
+		},
+	)
+	if err != hestiaError.OK {
+		t.Fatalf("IDBTransact(...) = %v, want OK", err)
+	}
+
+	if !reflect.DeepEqual(names, []string{"d", "c", "b", "a"}) {
+		t.Fatalf("cursor names = %v, want [d c b a]", names)
+	}
+
+	names = __namesIDBTest(t, db, &IDBQuery{Store: "users"})
+	if !reflect.DeepEqual(names, []string{"a", "B", "d"}) {
+		t.Fatalf("names after cursor = %v, want [a B d]", names)
+	}
+}
+
+func TestIDBKeyRange(t *testing.T) {
+	var db *IDB
+	var names []string
+	var err hestiaError.Error
+
+	db = __openIDBTest(t, "range", 1, __upgradeIDBTest)
+	defer IDBClose(db)
+
+	__addIDBTest(t, db, "a", "b", "c", "d", "e")
+
+	names = __namesIDBTest(t, db, &IDBQuery{
+		Store: "users",
+		Range: &IDBKeyRange{Lower: 2, Upper: 4},
+	})
+	if !reflect.DeepEqual(names, []string{"b", "c", "d"}) {
+		t.Fatalf("closed range = %v, want [b c d]", names)
+	}
+
+	names = __namesIDBTest(t, db, &IDBQuery{
+		Store: "users",
+		Range: &IDBKeyRange{Lower: 2, Upper: 4, LowerOpen: true, UpperOpen: true},
+	})
+	if !reflect.DeepEqual(names, []string{"c"}) {
+		t.Fatalf("open range = %v, want [c]", names)
+	}
+
+	names = __namesIDBTest(t, db, &IDBQuery{
+		Store:     "users",
+		Range:     &IDBKeyRange{Lower: 3},
+		Direction: IDB_DIRECTION_PREV,
+		Limit:     2,
+	})
+	if !reflect.DeepEqual(names, []string{"e", "d"}) {
+		t.Fatalf("lower bound range = %v, want [e d]", names)
+	}
+
+	names = __namesIDBTest(t, db, &IDBQuery{
+		Store: "users",
+		Index: "name",
+		Range: &IDBKeyRange{Upper: "b"},
+	})
+	if !reflect.DeepEqual(names, []string{"a", "b"}) {
+		t.Fatalf("index range = %v, want [a b]", names)
+	}
+
+	err = IDBTransact(db, []string{"users"}, IDB_MODE_READWRITE,
+		func(tx *IDBTransaction) hestiaError.Error {
+			return IDBDelete(tx, "users", &IDBKeyRange{Lower: 2, Upper: 3})
+		},
+	)
+	if err != hestiaError.OK {
+		t.Fatalf("IDBDelete(...) = %v, want OK", err)
+	}
+
+	names = __namesIDBTest(t, db, &IDBQuery{Store: "users"})
+	if !reflect.DeepEqual(names, []string{"a", "d", "e"}) {
+		t.Fatalf("names after IDBDelete(...) = %v, want [a d e]", names)
+	}
+}
+
+func TestIDBAbortRollback(t *testing.T) {
+	var db *IDB
+	var names []string
+	var err hestiaError.Error
+
+	db = __openIDBTest(t, "abort", 1, __upgradeIDBTest)
+	defer IDBClose(db)
+
+	__addIDBTest(t, db, "a")
+
+	// an error returned by fx aborts everything written before it
+	err = IDBTransact(db, []string{"users"}, IDB_MODE_READWRITE,
+		func(tx *IDBTransaction) hestiaError.Error {
+			_, _ = IDBAdd(tx, "users", map[string]any{"name": "b"}, nil)
+			_ = IDBClear(tx, "users")
+
+			return hestiaError.ECANCELED
+		},
+	)
+	if err != hestiaError.ECANCELED {
+		t.Fatalf("IDBTransact(...) = %v, want ECANCELED", err)
+	}
+
+	// like Javascript, a failed operation aborts even when fx ignores it
+	err = IDBTransact(db, []string{"users"}, IDB_MODE_READWRITE,
+		func(tx *IDBTransaction) hestiaError.Error {
+			_, _ = IDBAdd(tx, "users", map[string]any{"name": "c"}, nil)
+			_, _ = IDBAdd(tx, "users", map[string]any{"name": "a"}, nil)
+
+			return hestiaError.OK
+		},
+	)
+	if err != hestiaError.EEXIST {
+		t.Fatalf("IDBTransact(...) = %v, want EEXIST", err)
+	}
+
+	err = IDBTransact(db, []string{"users"}, IDB_MODE_READONLY,
+		func(tx *IDBTransaction) hestiaError.Error {
+			_, err = IDBPut(tx, "users", map[string]any{"name": "d"}, nil)
+			return err
+		},
+	)
+	if err != hestiaError.EROFS {
+		t.Fatalf("IDBPut(...) read-only = %v, want EROFS", err)
+	}
+
+	names = __namesIDBTest(t, db, &IDBQuery{Store: "users"})
+	if !reflect.DeepEqual(names, []string{"a"}) {
+		t.Fatalf("names after aborts = %v, want [a]", names)
+	}
+}
+
+func TestIDBTransactOverlapping(t *testing.T) {
+	var db *IDB
+	var waiter sync.WaitGroup
+	var counter float64
+	var i int
+	var err hestiaError.Error
+
+	db = __openIDBTest(t, "overlap", 1, func(upgrade *IDBUpgrade) hestiaError.Error {
+		_ = IDBCreateStore(upgrade, &IDBStoreOptions{Name: "counter"})
+		return IDBCreateStore(upgrade, &IDBStoreOptions{Name: "other"})
+	})
+	defer IDBClose(db)
+
+	// overlapping read-write transactions run one after another
+	for i = 0; i < 20; i++ {
+		waiter.Add(1)
+		go func() {
+			defer waiter.Done()
+
+			err := IDBTransact(db, []string{"other", "counter"},
+				IDB_MODE_READWRITE,
+				func(tx *IDBTransaction) hestiaError.Error {
+					var value float64
+
+					_ = IDBGet(tx, "counter", "value", &value)
+					_, err := IDBPut(tx, "counter", value+1, "value")
+
+					return err
+				},
+			)
+			if err != hestiaError.OK {
+				t.Errorf("IDBTransact(...) = %v, want OK", err)
+			}
+		}()
+	}
+	waiter.Wait()
+
+	err = IDBTransact(db, []string{"counter"}, IDB_MODE_READONLY,
+		func(tx *IDBTransaction) hestiaError.Error {
+			// a non-overlapping transaction is not blocked by this one
+			err = IDBTransact(db, []string{"other"}, IDB_MODE_READWRITE, __idbNoop)
+			if err != hestiaError.OK {
+				return err
+			}
+
+			return IDBGet(tx, "counter", "value", &counter)
+		},
+	)
+	if err != hestiaError.OK || counter != 20 {
+		t.Fatalf("counter = %v, %v, want 20", counter, err)
+	}
+}
+
+// NOTE: all functions below are test helpers.
+
+func __addIDBTest(t *testing.T, db *IDB, names ...string) {
+	var name string
+	var err hestiaError.Error
+
+	err = IDBTransact(db, []string{"users"}, IDB_MODE_READWRITE,
+		func(tx *IDBTransaction) hestiaError.Error {
+			for _, name = range names {
+				// without "id" so the key is auto-incremented
+				_, err = IDBAdd(tx, "users", map[string]any{
+					"name": name,
+					"tags": []string{"all", name},
+				}, nil)
+				if err != hestiaError.OK {
+					return err
+				}
+			}
+
+			return hestiaError.OK
+		},
+	)
+	if err != hestiaError.OK {
+		t.Fatalf("IDBAdd(...) = %v, want OK", err)
+	}
+}
+
+func __idbNoop(tx *IDBTransaction) hestiaError.Error {
+	return hestiaError.OK
+}
+
+func __namesIDBTest(t *testing.T, db *IDB, query *IDBQuery) (names []string) {
+	var users []idbTestUser
+	var user idbTestUser
+	var err hestiaError.Error
+
+	err = IDBTransact(db, []string{query.Store}, IDB_MODE_READONLY,
+		func(tx *IDBTransaction) hestiaError.Error {
+			return IDBGetAll(tx, query, &users)
+		},
+	)
+	if err != hestiaError.OK {
+		t.Fatalf("IDBGetAll(...) = %v, want OK", err)
+	}
+
+	names = []string{}
+	for _, user = range users {
+		names = append(names, user.Name)
+	}
+
+	return names
+}
+
+func __openIDBTest(t *testing.T,
+	name string,
+	version uint64,
+	upgrade func(*IDBUpgrade) hestiaError.Error) *IDB {
+	var db *IDB
+	var err hestiaError.Error
+
+	db = &IDB{
+		Name:      t.Name() + "/" + name,
+		Version:   version,
+		OnUpgrade: upgrade,
+	}
+
+	err = IDBOpen(db)
+	if err != hestiaError.OK {
+		t.Fatalf("IDBOpen(...) = %v, want OK", err)
+	}
+
+	t.Cleanup(func() {
+		_ = IDBDeleteDatabase(db.Name)
+	})
+
+	return db
+}
+
+func __upgradeIDBTest(upgrade *IDBUpgrade) hestiaError.Error {
+	var err hestiaError.Error
+
+	err = IDBCreateStore(upgrade, &IDBStoreOptions{
+		Name:          "users",
+		KeyPath:       "id",
+		AutoIncrement: true,
+	})
+	if err != hestiaError.OK {
+		return err
+	}
+
+	err = IDBCreateIndex(upgrade, "users", &IDBIndexOptions{
+		Name:    "name",
+		KeyPath: "name",
+		Unique:  true,
+	})
+	if err != hestiaError.OK {
+		return err
+	}
+
+	return IDBCreateIndex(upgrade, "users", &IDBIndexOptions{
+		Name:       "tags",
+		KeyPath:    "tags",
+		MultiEntry: true,
+	})
+}
@@ -0,0 +1,795 @@
+// Copyright 2022 "Holloway" Chew, Kean Ho <hollowaykeanho@gmail.com>
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+//go:build wasm
+// +build wasm
+
+package hestiaWASM
+
+import (
+	"hestiaGo/hestiaError"
+	"syscall/js"
+)
+
+const (
+	idb_ERROR_ABORT                = "AbortError"
+	idb_ERROR_CONSTRAINT           = "ConstraintError"
+	idb_ERROR_DATA                 = "DataError"
+	idb_ERROR_DATA_CLONE           = "DataCloneError"
+	idb_ERROR_INVALID_ACCESS       = "InvalidAccessError"
+	idb_ERROR_INVALID_STATE        = "InvalidStateError"
+	idb_ERROR_NOT_FOUND            = "NotFoundError"
+	idb_ERROR_QUOTA                = "QuotaExceededError"
+	idb_ERROR_READ_ONLY            = "ReadOnlyError"
+	idb_ERROR_SECURITY             = "SecurityError"
+	idb_ERROR_TRANSACTION_INACTIVE = "TransactionInactiveError"
+	idb_ERROR_VERSION              = "VersionError"
+)
+
+var idbDirections = []string{
+	IDB_DIRECTION_NEXT:        "next",
+	IDB_DIRECTION_NEXT_UNIQUE: "nextunique",
+	IDB_DIRECTION_PREV:        "prev",
+	IDB_DIRECTION_PREV_UNIQUE: "prevunique",
+}
+
+type idbHandle struct {
+	db      js.Value
+	handler js.Func
+	closed  bool
+}
+
+type idbUpgradeHandle struct {
+	db js.Value
+	tx js.Value
+}
+
+type idbTxHandle struct {
+	tx      js.Value
+	cursors []*idbCursorHandle
+	done    bool
+}
+
+type idbCursorHandle struct {
+	tx       *idbTxHandle
+	request  js.Value
+	cursor   js.Value
+	results  chan idbResult
+	handlers []js.Func
+}
+
+type idbResult struct {
+	value js.Value
+	err   hestiaError.Error
+}
+
+func _idbClear(tx *IDBTransaction, name string) hestiaError.Error {
+	var store js.Value
+	var err hestiaError.Error
+
+	store, err = __idbStore(tx, name)
+	if err != hestiaError.OK {
+		return err
+	}
+
+	_, err = __idbRequest(store, id_JS_IDB_CLEAR)
+
+	return err
+}
+
+func _idbClose(db *IDB) {
+	db.handle.db.Set(id_JS_IDB_ON_VERSION_CHANGE, js.Null())
+	db.handle.db.Call(id_JS_IDB_CLOSE)
+	db.handle.closed = true
+
+	__release(FUNC_KIND_INDEXEDDB, &db.handle.handler)
+}
+
+func _idbCount(tx *IDBTransaction, query *IDBQuery) (uint64, hestiaError.Error) {
+	var source, keys, ret js.Value
+	var err hestiaError.Error
+
+	source, keys, err = __idbSource(tx, query)
+	if err != hestiaError.OK {
+		return 0, err
+	}
+
+	ret, err = __idbRequest(source, id_JS_IDB_COUNT, keys)
+	if err != hestiaError.OK {
+		return 0, err
+	}
+
+	return uint64(ret.Float()), hestiaError.OK
+}
+
+func _idbCreateIndex(upgrade *IDBUpgrade, name string, options *IDBIndexOptions) hestiaError.Error {
+	var store js.Value
+	var err hestiaError.Error
+
+	store, err = __idbCall(upgrade.handle.tx, id_JS_IDB_OBJECT_STORE, name)
+	if err != hestiaError.OK {
+		return err
+	}
+
+	_, err = __idbCall(store,
+		id_JS_IDB_CREATE_INDEX,
+		options.Name,
+		options.KeyPath,
+		map[string]any{
+			id_JS_IDB_UNIQUE:      options.Unique,
+			id_JS_IDB_MULTI_ENTRY: options.MultiEntry,
+		},
+	)
+
+	return err
+}
+
+func _idbCreateStore(upgrade *IDBUpgrade, options *IDBStoreOptions) (err hestiaError.Error) {
+	var config map[string]any
+
+	config = map[string]any{
+		id_JS_IDB_AUTO_INCREMENT: options.AutoIncrement,
+	}
+
+	if options.KeyPath != "" {
+		config[id_JS_IDB_KEY_PATH] = options.KeyPath
+	}
+
+	_, err = __idbCall(upgrade.handle.db,
+		id_JS_IDB_CREATE_OBJECT_STORE,
+		options.Name,
+		config,
+	)
+
+	return err
+}
+
+func _idbCursorContinue(cursor *IDBCursor) (err hestiaError.Error) {
+	var handle *idbCursorHandle
+
+	handle = cursor.handle
+	if handle.handlers == nil {
+		return hestiaError.ENODATA
+	}
+
+	_, err = __idbCall(handle.cursor, id_JS_IDB_CONTINUE)
+	if err != hestiaError.OK {
+		return err
+	}
+
+	return __idbCursorNext(cursor)
+}
+
+func _idbCursorDelete(cursor *IDBCursor) (err hestiaError.Error) {
+	_, err = __idbRequest(cursor.handle.cursor, id_JS_IDB_DELETE)
+	return err
+}
+
+func _idbCursorUpdate(cursor *IDBCursor, value any) (err hestiaError.Error) {
+	_, err = __idbRequest(cursor.handle.cursor, id_JS_IDB_UPDATE, value)
+	return err
+}
+
+func _idbDelete(tx *IDBTransaction, name string, keys *IDBKeyRange) hestiaError.Error {
+	var store, target js.Value
+	var err hestiaError.Error
+
+	store, err = __idbStore(tx, name)
+	if err != hestiaError.OK {
+		return err
+	}
+
+	target, err = __idbKeyRangeOf(keys)
+	if err != hestiaError.OK {
+		return err
+	}
+
+	// Javascript rejects an unbounded range
+	if target.IsUndefined() {
+		_, err = __idbRequest(store, id_JS_IDB_CLEAR)
+		return err
+	}
+
+	_, err = __idbRequest(store, id_JS_IDB_DELETE, target)
+
+	return err
+}
+
+func _idbDeleteDatabase(name string) hestiaError.Error {
+	var factory js.Value
+	var err hestiaError.Error
+
+	factory, err = __idbFactory()
+	if err != hestiaError.OK {
+		return err
+	}
+
+	_, err = __idbRequest(factory, id_JS_IDB_DELETE_DATABASE, name)
+
+	return err
+}
+
+func _idbDeleteIndex(upgrade *IDBUpgrade, name string, index string) hestiaError.Error {
+	var store js.Value
+	var err hestiaError.Error
+
+	store, err = __idbCall(upgrade.handle.tx, id_JS_IDB_OBJECT_STORE, name)
+	if err != hestiaError.OK {
+		return err
+	}
+
+	_, err = __idbCall(store, id_JS_IDB_DELETE_INDEX, index)
+
+	return err
+}
+
+func _idbDeleteStore(upgrade *IDBUpgrade, name string) (err hestiaError.Error) {
+	_, err = __idbCall(upgrade.handle.db, id_JS_IDB_DELETE_OBJECT_STORE, name)
+	return err
+}
+
+func _idbGet(tx *IDBTransaction, name string, key any) (any, hestiaError.Error) {
+	var store, ret js.Value
+	var err hestiaError.Error
+
+	store, err = __idbStore(tx, name)
+	if err != hestiaError.OK {
+		return nil, err
+	}
+
+	ret, err = __idbRequest(store, id_JS_IDB_GET, key)
+	if err != hestiaError.OK {
+		return nil, err
+	}
+
+	if ret.IsUndefined() {
+		return nil, hestiaError.ENODATA
+	}
+
+	return __convertValue(ret, convert_DEPTH_DEFAULT, nil)
+}
+
+func _idbGetAll(tx *IDBTransaction, query *IDBQuery) (list []any, err hestiaError.Error) {
+	var source, keys, ret js.Value
+	var cursor *IDBCursor
+	var limit any
+	var out any
+
+	// getAll() only reads in ascending order
+	if query.Direction != IDB_DIRECTION_NEXT {
+		cursor, err = _idbOpenCursor(tx, query)
+		for err == hestiaError.OK {
+			list = append(list, cursor.Value)
+			if query.Limit > 0 && uint(len(list)) >= query.Limit {
+				__idbCursorRelease(cursor.handle)
+				break
+			}
+
+			err = _idbCursorContinue(cursor)
+		}
+
+		if err != hestiaError.OK && err != hestiaError.ENODATA {
+			return nil, err
+		}
+
+		return list, hestiaError.OK
+	}
+
+	source, keys, err = __idbSource(tx, query)
+	if err != hestiaError.OK {
+		return nil, err
+	}
+
+	if query.Limit > 0 {
+		limit = query.Limit
+	}
+
+	ret, err = __idbRequest(source, id_JS_IDB_GET_ALL, keys, limit)
+	if err != hestiaError.OK {
+		return nil, err
+	}
+
+	out, err = __convertValue(ret, convert_DEPTH_DEFAULT+1, nil)
+	if err != hestiaError.OK {
+		return nil, err
+	}
+
+	list, _ = out.([]any)
+
+	return list, hestiaError.OK
+}
+
+func _idbOpen(db *IDB) (handle *idbHandle, err hestiaError.Error) {
+	var factory, request, ret js.Value
+	var upgrade js.Func
+	var failure hestiaError.Error
+
+	factory, err = __idbFactory()
+	if err != hestiaError.OK {
+		return nil, err
+	}
+
+	request, err = __idbCall(factory, id_JS_IDB_OPEN, db.Name, float64(db.Version))
+	if err != hestiaError.OK {
+		return nil, err
+	}
+
+	upgrade = __funcOf(FUNC_KIND_INDEXEDDB, func(this js.Value, args []js.Value) any {
+		var data *IDBUpgrade
+
+		data = &IDBUpgrade{
+			handle: &idbUpgradeHandle{
+				db: request.Get(id_JS_IDB_RESULT),
+				tx: request.Get(id_JS_IDB_TRANSACTION),
+			},
+			OldVersion: uint64(args[0].Get(id_JS_IDB_OLD_VERSION).Float()),
+			NewVersion: uint64(args[0].Get(id_JS_IDB_NEW_VERSION).Float()),
+		}
+
+		// stores can only be created synchronously in this callback
+		if db.OnUpgrade != nil {
+			failure = db.OnUpgrade(data)
+		}
+		data.handle = nil
+
+		if failure != hestiaError.OK {
+			_, _ = __idbCall(request.Get(id_JS_IDB_TRANSACTION), id_JS_IDB_ABORT)
+		}
+
+		return nil
+	})
+	request.Set(id_JS_IDB_ON_UPGRADE_NEEDED, upgrade)
+
+	ret, err = __idbWait(request)
+	request.Set(id_JS_IDB_ON_UPGRADE_NEEDED, js.Null())
+	__release(FUNC_KIND_INDEXEDDB, &upgrade)
+
+	switch {
+	case failure != hestiaError.OK:
+		return nil, hestiaError.ECANCELED
+	case err != hestiaError.OK:
+		return nil, err
+	}
+
+	handle = &idbHandle{
+		db: ret,
+	}
+
+	handle.handler = __funcOf(FUNC_KIND_INDEXEDDB, func(this js.Value, args []js.Value) any {
+		if db.OnVersionChange != nil {
+			go db.OnVersionChange()
+			return nil
+		}
+
+		// step aside so the other page is not blocked
+		handle.closed = true
+		ret.Call(id_JS_IDB_CLOSE)
+
+		return nil
+	})
+	ret.Set(id_JS_IDB_ON_VERSION_CHANGE, handle.handler)
+
+	return handle, hestiaError.OK
+}
+
+func _idbOpenCursor(tx *IDBTransaction, query *IDBQuery) (*IDBCursor, hestiaError.Error) {
+	var cursor *IDBCursor
+	var source, keys, request js.Value
+	var err hestiaError.Error
+
+	source, keys, err = __idbSource(tx, query)
+	if err != hestiaError.OK {
+		return nil, err
+	}
+
+	request, err = __idbCall(source,
+		id_JS_IDB_OPEN_CURSOR,
+		keys,
+		idbDirections[query.Direction],
+	)
+	if err != hestiaError.OK {
+		return nil, err
+	}
+
+	// the same request succeeds again for each continue()
+	cursor = &IDBCursor{
+		handle: &idbCursorHandle{
+			tx:      tx.handle,
+			request: request,
+			results: make(chan idbResult, 1),
+		},
+	}
+	cursor.handle.handlers = __idbListen(request, cursor.handle.results)
+	tx.handle.cursors = append(tx.handle.cursors, cursor.handle)
+
+	err = __idbCursorNext(cursor)
+	if err != hestiaError.OK {
+		return nil, err
+	}
+
+	return cursor, hestiaError.OK
+}
+
+func _idbPut(tx *IDBTransaction,
+	name string,
+	value any,
+	key any,
+	isAdd bool) (any, hestiaError.Error) {
+	var store, ret js.Value
+	var method string
+	var args []any
+	var err hestiaError.Error
+
+	store, err = __idbStore(tx, name)
+	if err != hestiaError.OK {
+		return nil, err
+	}
+
+	method = id_JS_IDB_PUT
+	if isAdd {
+		method = id_JS_IDB_ADD
+	}
+
+	args = []any{value}
+	if key != nil {
+		args = append(args, key)
+	}
+
+	ret, err = __idbRequest(store, method, args...)
+	if err != hestiaError.OK {
+		return nil, err
+	}
+
+	return __convertValue(ret, convert_DEPTH_DEFAULT, nil)
+}
+
+func _idbTransact(handle *idbHandle,
+	stores []string,
+	mode IDBMode,
+	fx func(tx *IDBTransaction) hestiaError.Error) (err hestiaError.Error) {
+	var names []any
+	var tx *IDBTransaction
+	var results chan hestiaError.Error
+	var complete, abort js.Func
+	var target js.Value
+	var modeName string
+	var cursor *idbCursorHandle
+	var ret hestiaError.Error
+	var i int
+
+	if handle.closed {
+		return hestiaError.ENOTCONN
+	}
+
+	names = make([]any, len(stores))
+	for i = range stores {
+		names[i] = stores[i]
+	}
+
+	modeName = id_JS_IDB_READONLY
+	if mode == IDB_MODE_READWRITE {
+		modeName = id_JS_IDB_READWRITE
+	}
+
+	target, err = __idbCall(handle.db, id_JS_IDB_TRANSACTION, names, modeName)
+	if err != hestiaError.OK {
+		return err
+	}
+
+	results = make(chan hestiaError.Error, 1)
+	complete = __funcOf(FUNC_KIND_INDEXEDDB, func(this js.Value, args []js.Value) any {
+		results <- hestiaError.OK
+		return nil
+	})
+
+	abort = __funcOf(FUNC_KIND_INDEXEDDB, func(this js.Value, args []js.Value) any {
+		results <- __idbError(target.Get(id_JS_IDB_ERROR), hestiaError.ECANCELED)
+		return nil
+	})
+
+	target.Set(id_JS_IDB_ON_COMPLETE, complete)
+	target.Set(id_JS_IDB_ON_ABORT, abort)
+
+	tx = &IDBTransaction{
+		Mode: mode,
+		handle: &idbTxHandle{
+			tx: target,
+		},
+	}
+
+	err = fx(tx)
+
+	// commit early instead of waiting for the idle auto-commit
+	if err != hestiaError.OK {
+		_, _ = __idbCall(target, id_JS_IDB_ABORT)
+	} else if target.Get(id_JS_IDB_COMMIT).Type() == js.TypeFunction {
+		_, _ = __idbCall(target, id_JS_IDB_COMMIT)
+	}
+
+	ret = <-results
+	tx.handle.done = true
+
+	for _, cursor = range tx.handle.cursors {
+		__idbCursorRelease(cursor)
+	}
+
+	__release(FUNC_KIND_INDEXEDDB, &complete)
+	__release(FUNC_KIND_INDEXEDDB, &abort)
+
+	if err != hestiaError.OK {
+		return err
+	}
+
+	return ret
+}
+
+// NOTE: all functions below are sub-functions. Please use the global version
+// since it has proper guarding like `nil` object checking.
+
+func __idbCall(target js.Value, method string, args ...any) (ret js.Value, err hestiaError.Error) {
+	defer func() {
+		var r any
+		var exception js.Error
+		var ok bool
+
+		r = recover()
+		if r == nil {
+			return
+		}
+
+		ret = js.Undefined()
+		err = hestiaError.EINVAL
+
+		// Javascript throws DOMException synchronously for invalid calls
+		exception, ok = r.(js.Error)
+		if ok {
+			err = __idbError(exception.Value, hestiaError.EINVAL)
+		}
+	}()
+
+	return target.Call(method, args...), hestiaError.OK
+}
+
+func __idbCursorNext(cursor *IDBCursor) hestiaError.Error {
+	var handle *idbCursorHandle
+	var result idbResult
+
+	handle = cursor.handle
+	result = <-handle.results
+	if result.err != hestiaError.OK {
+		__idbCursorRelease(handle)
+		return result.err
+	}
+
+	if result.value.IsNull() {
+		__idbCursorRelease(handle)
+		return hestiaError.ENODATA
+	}
+
+	handle.cursor = result.value
+	cursor.Key, _ = __convertValue(result.value.Get(id_JS_IDB_KEY),
+		convert_DEPTH_DEFAULT,
+		nil,
+	)
+	cursor.PrimaryKey, _ = __convertValue(result.value.Get(id_JS_IDB_PRIMARY_KEY),
+		convert_DEPTH_DEFAULT,
+		nil,
+	)
+	cursor.Value, _ = __convertValue(result.value.Get(id_JS_IDB_VALUE),
+		convert_DEPTH_DEFAULT,
+		nil,
+	)
+
+	return hestiaError.OK
+}
+
+func __idbCursorRelease(handle *idbCursorHandle) {
+	var i int
+
+	if handle.handlers == nil {
+		return
+	}
+
+	handle.request.Set(id_JS_IDB_ON_SUCCESS, js.Null())
+	handle.request.Set(id_JS_IDB_ON_ERROR, js.Null())
+
+	for i = range handle.handlers {
+		__release(FUNC_KIND_INDEXEDDB, &handle.handlers[i])
+	}
+	handle.handlers = nil
+}
+
+func __idbError(exception js.Value, fallback hestiaError.Error) hestiaError.Error {
+	if exception.Type() != js.TypeObject {
+		return fallback
+	}
+
+	switch __stringOf(exception.Get(id_JS_IDB_NAME)) {
+	case idb_ERROR_ABORT:
+		return hestiaError.ECANCELED
+	case idb_ERROR_CONSTRAINT:
+		return hestiaError.EEXIST
+	case idb_ERROR_DATA, idb_ERROR_INVALID_ACCESS:
+		return hestiaError.EINVAL
+	case idb_ERROR_DATA_CLONE:
+		return hestiaError.EILSEQ
+	case idb_ERROR_INVALID_STATE, idb_ERROR_TRANSACTION_INACTIVE:
+		return hestiaError.EBADFD
+	case idb_ERROR_NOT_FOUND:
+		return hestiaError.ENOENT
+	case idb_ERROR_QUOTA:
+		return hestiaError.ENOSPC
+	case idb_ERROR_READ_ONLY:
+		return hestiaError.EROFS
+	case idb_ERROR_SECURITY:
+		return hestiaError.EACCES
+	case idb_ERROR_VERSION:
+		return hestiaError.ESTALE
+	default:
+		return hestiaError.EIO
+	}
+}
+
+func __idbFactory() (factory js.Value, err hestiaError.Error) {
+	defer func() {
+		// accessing a disabled IndexedDB throws SecurityError
+		if r := recover(); r != nil {
+			factory = js.Undefined()
+			err = hestiaError.EACCES
+		}
+	}()
+
+	factory = Global().value.Get(id_JS_IDB)
+	if factory.Type() != js.TypeObject {
+		return js.Undefined(), hestiaError.EACCES
+	}
+
+	return factory, hestiaError.OK
+}
+
+func __idbKeyRangeOf(keys *IDBKeyRange) (js.Value, hestiaError.Error) {
+	var constructor js.Value
+	var lower, upper any
+	var err hestiaError.Error
+
+	if keys == nil {
+		return js.Undefined(), hestiaError.OK
+	}
+
+	lower, upper, err = __idbKeyRange(keys)
+	if err != hestiaError.OK {
+		return js.Undefined(), err
+	}
+
+	constructor = Global().value.Get(id_JS_IDB_KEY_RANGE)
+
+	switch {
+	case lower != nil && upper != nil && __idbCompare(lower, upper) == 0:
+		return __idbCall(constructor, id_JS_IDB_ONLY, lower)
+	case lower != nil && upper != nil:
+		return __idbCall(constructor,
+			id_JS_IDB_BOUND,
+			lower,
+			upper,
+			keys.LowerOpen,
+			keys.UpperOpen,
+		)
+	case lower != nil:
+		return __idbCall(constructor, id_JS_IDB_LOWER_BOUND, lower, keys.LowerOpen)
+	case upper != nil:
+		return __idbCall(constructor, id_JS_IDB_UPPER_BOUND, upper, keys.UpperOpen)
+	default:
+		return js.Undefined(), hestiaError.OK
+	}
+}
+
+func __idbListen(request js.Value, results chan idbResult) []js.Func {
+	var success, failure js.Func
+
+	// never block the Javascript callback
+	success = __funcOf(FUNC_KIND_INDEXEDDB, func(this js.Value, args []js.Value) any {
+		select {
+		case results <- idbResult{value: request.Get(id_JS_IDB_RESULT)}:
+		default:
+		}
+
+		return nil
+	})
+
+	failure = __funcOf(FUNC_KIND_INDEXEDDB, func(this js.Value, args []js.Value) any {
+		select {
+		case results <- idbResult{
+			value: js.Undefined(),
+			err:   __idbError(request.Get(id_JS_IDB_ERROR), hestiaError.EIO),
+		}:
+		default:
+		}
+
+		return nil
+	})
+
+	request.Set(id_JS_IDB_ON_SUCCESS, success)
+	request.Set(id_JS_IDB_ON_ERROR, failure)
+
+	return []js.Func{success, failure}
+}
+
+func __idbRequest(target js.Value, method string, args ...any) (js.Value, hestiaError.Error) {
+	var request js.Value
+	var err hestiaError.Error
+
+	request, err = __idbCall(target, method, args...)
+	if err != hestiaError.OK {
+		return js.Undefined(), err
+	}
+
+	return __idbWait(request)
+}
+
+func __idbSource(tx *IDBTransaction, query *IDBQuery) (source js.Value,
+	keys js.Value,
+	err hestiaError.Error) {
+	source, err = __idbStore(tx, query.Store)
+	if err != hestiaError.OK {
+		return js.Undefined(), js.Undefined(), err
+	}
+
+	if query.Index != "" {
+		source, err = __idbCall(source, id_JS_IDB_INDEX, query.Index)
+		if err != hestiaError.OK {
+			return js.Undefined(), js.Undefined(), err
+		}
+	}
+
+	keys, err = __idbKeyRangeOf(query.Range)
+	if err != hestiaError.OK {
+		return js.Undefined(), js.Undefined(), err
+	}
+
+	return source, keys, hestiaError.OK
+}
+
+func __idbStore(tx *IDBTransaction, name string) (js.Value, hestiaError.Error) {
+	if tx.handle.done {
+		return js.Undefined(), hestiaError.EBADFD
+	}
+
+	return __idbCall(tx.handle.tx, id_JS_IDB_OBJECT_STORE, name)
+}
+
+func __idbWait(request js.Value) (js.Value, hestiaError.Error) {
+	var results chan idbResult
+	var handlers []js.Func
+	var result idbResult
+	var i int
+
+	results = make(chan idbResult, 1)
+	handlers = __idbListen(request, results)
+
+	result = <-results
+
+	request.Set(id_JS_IDB_ON_SUCCESS, js.Null())
+	request.Set(id_JS_IDB_ON_ERROR, js.Null())
+	for i = range handlers {
+		__release(FUNC_KIND_INDEXEDDB, &handlers[i])
+	}
+
+	return result.value, result.err
+}
@@ -0,0 +1,489 @@
+// Copyright 2022 "Holloway" Chew, Kean Ho <hollowaykeanho@gmail.com>
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package hestiaWASM
+
+import (
+	"hestiaGo/hestiaError"
+	"sync"
+)
+
+// IntersectionEntry is the Go format of Javascript IntersectionObserverEntry.
+type IntersectionEntry struct {
+	// Target is the observed Object.
+	Target *Object
+
+	// BoundingClientRect is the Target's bounding rectangle.
+	BoundingClientRect Rect
+
+	// IntersectionRect is the visible part of Target within the root.
+	IntersectionRect Rect
+
+	// RootBounds is the root's rectangle after applying root margin.
+	//
+	// It is `nil` when the root is outside of the document (e.g. cross-origin
+	// iframe).
+	RootBounds *Rect
+
+	// IntersectionRatio is the visible ratio of Target (`0.0` to `1.0`).
+	IntersectionRatio float64
+
+	// Time is the timestamp in milliseconds of the intersection change.
+	Time float64
+
+	// IsIntersecting states Target is intersecting the root.
+	IsIntersecting bool
+}
+
+// IntersectionObserver is the hestiaWASM adapter for Javascript
+// IntersectionObserver.
+//
+// Since Javascript only accepts root options when constructing the observer,
+// the Root, RootMargin, and Threshold fields are only read by the first
+// `ObserveIntersection(...)` after creation or `DisconnectIntersection(...)`.
+//
+// Like EventListener, the Javascript handler is created when the first target
+// is observed and is released when the last target is unobserved or the
+// observer is disconnected. It is ultimately your responsibility to keep the
+// IntersectionObserver object for memory management.
+type IntersectionObserver struct {
+	// Function is the listening function to execute.
+	//
+	// This function is executed in a separate goroutine with all the entries
+	// of the same Javascript callback.
+	Function func([]*IntersectionEntry)
+
+	// Root is the ancestor Object used as viewport for checking visibility.
+	//
+	// Default (`nil`) is the browser viewport.
+	Root *Object
+
+	// RootMargin is the CSS margin around Root (e.g. `"0px 0px 200px 0px"`).
+	//
+	// Default (`""`) is `"0px"`.
+	RootMargin string
+
+	// Threshold is the list of visible ratios triggering the Function.
+	//
+	// Default (`nil`) is `[0]`.
+	Threshold []float64
+
+	observer observerCore
+}
+
+// MutationObserver is the hestiaWASM adapter for Javascript MutationObserver.
+//
+// Like EventListener, the Javascript handler is created when the first target
+// is observed and is released when the last target is unobserved or the
+// observer is disconnected. It is ultimately your responsibility to keep the
+// MutationObserver object for memory management.
+type MutationObserver struct {
+	// Function is the listening function to execute.
+	//
+	// This function is executed in a separate goroutine with all the records
+	// of the same Javascript callback.
+	Function func([]*MutationRecord)
+
+	observer observerCore
+}
+
+// MutationOptions is the observing options for `ObserveMutation(...)`.
+//
+// At least one of ChildList, Attributes, or CharacterData **MUST** be `true`
+// (or implied by AttributeFilter, AttributeOldValue, and
+// CharacterDataOldValue).
+type MutationOptions struct {
+	// AttributeFilter limits the observed attributes to the given names.
+	//
+	// Default (`nil`) is all attributes.
+	AttributeFilter []string
+
+	// Attributes observes attribute changes.
+	Attributes bool
+
+	// AttributeOldValue records the attribute's previous value.
+	AttributeOldValue bool
+
+	// CharacterData observes text content changes.
+	CharacterData bool
+
+	// CharacterDataOldValue records the text content's previous value.
+	CharacterDataOldValue bool
+
+	// ChildList observes adding and removing of child nodes.
+	ChildList bool
+
+	// Subtree extends the observation to all the target's descendants.
+	Subtree bool
+}
+
+// MutationRecord is the Go format of Javascript MutationRecord.
+type MutationRecord struct {
+	// Type is the mutation type (`attributes`, `characterData`, or
+	// `childList`).
+	Type string
+
+	// Target is the mutated node.
+	Target *Object
+
+	// AddedNodes is the list of added nodes.
+	AddedNodes []*Object
+
+	// RemovedNodes is the list of removed nodes.
+	RemovedNodes []*Object
+
+	// PreviousSibling is the previous sibling of the added or removed nodes.
+	PreviousSibling *Object
+
+	// NextSibling is the next sibling of the added or removed nodes.
+	NextSibling *Object
+
+	// AttributeName is the name of the changed attribute.
+	AttributeName string
+
+	// AttributeNamespace is the namespace of the changed attribute.
+	AttributeNamespace string
+
+	// OldValue is the previous value when its `*OldValue` option is set.
+	OldValue string
+}
+
+// Rect is the Go format of Javascript DOMRect in pixels.
+type Rect struct {
+	X      float64
+	Y      float64
+	Width  float64
+	Height float64
+	Top    float64
+	Right  float64
+	Bottom float64
+	Left   float64
+}
+
+// ResizeBox is the box model observed by ResizeObserver.
+type ResizeBox uint8
+
+// ResizeBox representations ID
+const (
+	RESIZE_BOX_CONTENT              ResizeBox = 0
+	RESIZE_BOX_BORDER               ResizeBox = 1
+	RESIZE_BOX_DEVICE_PIXEL_CONTENT ResizeBox = 2
+)
+
+// ResizeEntry is the Go format of Javascript ResizeObserverEntry.
+type ResizeEntry struct {
+	// Target is the observed Object.
+	Target *Object
+
+	// ContentRect is the Target's content rectangle.
+	ContentRect Rect
+
+	// BorderBoxSize is the Target's border box sizes per fragment.
+	BorderBoxSize []ResizeSize
+
+	// ContentBoxSize is the Target's content box sizes per fragment.
+	ContentBoxSize []ResizeSize
+
+	// DevicePixelContentBoxSize is the Target's content box sizes in device
+	// pixels per fragment.
+	//
+	// It is `nil` when not supported by the browser.
+	DevicePixelContentBoxSize []ResizeSize
+}
+
+// ResizeObserver is the hestiaWASM adapter for Javascript ResizeObserver.
+//
+// Like EventListener, the Javascript handler is created when the first target
+// is observed and is released when the last target is unobserved or the
+// observer is disconnected. It is ultimately your responsibility to keep the
+// ResizeObserver object for memory management.
+type ResizeObserver struct {
+	// Function is the listening function to execute.
+	//
+	// This function is executed in a separate goroutine with all the entries
+	// of the same Javascript callback.
+	Function func([]*ResizeEntry)
+
+	observer observerCore
+}
+
+// ResizeOptions is the observing options for `ObserveResize(...)`.
+type ResizeOptions struct {
+	// Box is the observed box model.
+	//
+	// Default (`RESIZE_BOX_CONTENT`) is the content box.
+	Box ResizeBox
+}
+
+// ResizeSize is the Go format of Javascript ResizeObserverSize.
+type ResizeSize struct {
+	// InlineSize is the size in the inline direction (width for horizontal
+	// writing mode).
+	InlineSize float64
+
+	// BlockSize is the size in the block direction (height for horizontal
+	// writing mode).
+	BlockSize float64
+}
+
+type observerCore struct {
+	handler *Object
+	targets []*observerTarget
+	mutex   sync.Mutex
+}
+
+type observerTarget struct {
+	target  *Object
+	options map[string]any
+}
+
+// DisconnectIntersection stops observing all targets of an
+// IntersectionObserver.
+//
+// The Javascript handler is released. The observer can be reused by
+// `ObserveIntersection(...)` again afterwards.
+//
+// It accepts the following parameters:
+//   1. `observer` - the IntersectionObserver to disconnect.
+//
+// It shall returns:
+//   1. hestiaError.OK | `0` - operation successful.
+//   2. hestiaError.EOWNERDEAD - given `observer` is `nil`.
+//   3. hestiaError.EBADE - given `observer` is not observing anything.
+//   4. hestiaError.EPFNOSUPPORT | `96` - operating in a non-WASM CPU.
+func DisconnectIntersection(observer *IntersectionObserver) hestiaError.Error {
+	if observer == nil {
+		return hestiaError.EOWNERDEAD
+	}
+
+	return _disconnectObserver(&observer.observer)
+}
+
+// DisconnectMutation stops observing all targets of a MutationObserver.
+//
+// Pending records are discarded. The Javascript handler is released. The
+// observer can be reused by `ObserveMutation(...)` again afterwards.
+//
+// It accepts the following parameters:
+//   1. `observer` - the MutationObserver to disconnect.
+//
+// It shall returns:
+//   1. hestiaError.OK | `0` - operation successful.
+//   2. hestiaError.EOWNERDEAD - given `observer` is `nil`.
+//   3. hestiaError.EBADE - given `observer` is not observing anything.
+//   4. hestiaError.EPFNOSUPPORT | `96` - operating in a non-WASM CPU.
+func DisconnectMutation(observer *MutationObserver) hestiaError.Error {
+	if observer == nil {
+		return hestiaError.EOWNERDEAD
+	}
+
+	return _disconnectObserver(&observer.observer)
+}
+
+// DisconnectResize stops observing all targets of a ResizeObserver.
+//
+// The Javascript handler is released. The observer can be reused by
+// `ObserveResize(...)` again afterwards.
+//
+// It accepts the following parameters:
+//   1. `observer` - the ResizeObserver to disconnect.
+//
+// It shall returns:
+//   1. hestiaError.OK | `0` - operation successful.
+//   2. hestiaError.EOWNERDEAD - given `observer` is `nil`.
+//   3. hestiaError.EBADE - given `observer` is not observing anything.
+//   4. hestiaError.EPFNOSUPPORT | `96` - operating in a non-WASM CPU.
+func DisconnectResize(observer *ResizeObserver) hestiaError.Error {
+	if observer == nil {
+		return hestiaError.EOWNERDEAD
+	}
+
+	return _disconnectObserver(&observer.observer)
+}
+
+// ObserveIntersection starts observing the visibility of a target.
+//
+// It accepts the following parameters:
+//   1. `observer` - the IntersectionObserver.
+//   2. `target` - the Object to observe.
+//
+// It shall returns:
+//   1. hestiaError.OK | `0` - operation successful.
+//   2. hestiaError.EOWNERDEAD - given `observer` is `nil`.
+//   3. hestiaError.ENOMEDIUM - given `observer` has no Function.
+//   4. hestiaError.EINVAL - given `target` is unusable.
+//   5. hestiaError.EOPNOTSUPP - the browser does not support the observer.
+//   6. hestiaError.EPROTO - Javascript rejected the observer options (e.g.
+//                          malformed RootMargin or Threshold outside
+//                          `0.0` to `1.0`).
+//   7. hestiaError.EPFNOSUPPORT | `96` - operating in a non-WASM CPU.
+func ObserveIntersection(observer *IntersectionObserver, target *Object) hestiaError.Error {
+	if observer == nil {
+		return hestiaError.EOWNERDEAD
+	}
+
+	if observer.Function == nil {
+		return hestiaError.ENOMEDIUM
+	}
+
+	return _observeIntersection(observer, target)
+}
+
+// ObserveMutation starts observing the DOM changes of a target.
+//
+// Observing the same target again replaces its options.
+//
+// It accepts the following parameters:
+//   1. `observer` - the MutationObserver.
+//   2. `target` - the Object to observe.
+//   3. `options` - the observing options.
+//
+// It shall returns:
+//   1. hestiaError.OK | `0` - operation successful.
+//   2. hestiaError.EOWNERDEAD - given `observer` is `nil`.
+//   3. hestiaError.ENOMEDIUM - given `observer` has no Function.
+//   4. hestiaError.EINVAL - given `target` or `options` is unusable.
+//   5. hestiaError.EOPNOTSUPP - the browser does not support the observer.
+//   6. hestiaError.EPROTO - Javascript rejected the `options` (e.g. nothing
+//                          to observe).
+//   7. hestiaError.EPFNOSUPPORT | `96` - operating in a non-WASM CPU.
+func ObserveMutation(observer *MutationObserver,
+	target *Object,
+	options *MutationOptions) hestiaError.Error {
+	if observer == nil {
+		return hestiaError.EOWNERDEAD
+	}
+
+	if observer.Function == nil {
+		return hestiaError.ENOMEDIUM
+	}
+
+	if options == nil {
+		return hestiaError.EINVAL
+	}
+
+	return _observeMutation(observer, target, options)
+}
+
+// ObserveResize starts observing the size changes of a target.
+//
+// Observing the same target again replaces its options.
+//
+// It accepts the following parameters:
+//   1. `observer` - the ResizeObserver.
+//   2. `target` - the Object to observe.
+//   3. `options` - the observing options. Can be `nil` for default.
+//
+// It shall returns:
+//   1. hestiaError.OK | `0` - operation successful.
+//   2. hestiaError.EOWNERDEAD - given `observer` is `nil`.
+//   3. hestiaError.ENOMEDIUM - given `observer` has no Function.
+//   4. hestiaError.EINVAL - given `target` is unusable.
+//   5. hestiaError.EOPNOTSUPP - the browser does not support the observer.
+//   6. hestiaError.EPROTO - Javascript rejected the `options`.
+//   7. hestiaError.EPFNOSUPPORT | `96` - operating in a non-WASM CPU.
+func ObserveResize(observer *ResizeObserver,
+	target *Object,
+	options *ResizeOptions) hestiaError.Error {
+	if observer == nil {
+		return hestiaError.EOWNERDEAD
+	}
+
+	if observer.Function == nil {
+		return hestiaError.ENOMEDIUM
+	}
+
+	if options == nil {
+		options = &ResizeOptions{}
+	}
+
+	return _observeResize(observer, target, options)
+}
+
+// UnobserveIntersection stops observing a target of an IntersectionObserver.
+//
+// When the last target is unobserved, the observer is disconnected and its
+// Javascript handler is released.
+//
+// It accepts the following parameters:
+//   1. `observer` - the IntersectionObserver.
+//   2. `target` - the observed Object.
+//
+// It shall returns:
+//   1. hestiaError.OK | `0` - operation successful.
+//   2. hestiaError.EOWNERDEAD - given `observer` is `nil`.
+//   3. hestiaError.EINVAL - given `target` is unusable.
+//   4. hestiaError.EBADE - given `target` is not observed.
+//   5. hestiaError.EPFNOSUPPORT | `96` - operating in a non-WASM CPU.
+func UnobserveIntersection(observer *IntersectionObserver, target *Object) hestiaError.Error {
+	if observer == nil {
+		return hestiaError.EOWNERDEAD
+	}
+
+	return _unobserveObserver(&observer.observer, target)
+}
+
+// UnobserveMutation stops observing a target of a MutationObserver.
+//
+// Since Javascript MutationObserver cannot unobserve a single target, the
+// observer is disconnected and re-observes the remaining targets with their
+// options. Pending records are delivered to Function before that. When the
+// last target is unobserved, its Javascript handler is released.
+//
+// It accepts the following parameters:
+//   1. `observer` - the MutationObserver.
+//   2. `target` - the observed Object.
+//
+// It shall returns:
+//   1. hestiaError.OK | `0` - operation successful.
+//   2. hestiaError.EOWNERDEAD - given `observer` is `nil`.
+//   3. hestiaError.EINVAL - given `target` is unusable.
+//   4. hestiaError.EBADE - given `target` is not observed.
+//   5. hestiaError.EPFNOSUPPORT | `96` - operating in a non-WASM CPU.
+func UnobserveMutation(observer *MutationObserver, target *Object) hestiaError.Error {
+	if observer == nil {
+		return hestiaError.EOWNERDEAD
+	}
+
+	return _unobserveMutation(observer, target)
+}
+
+// UnobserveResize stops observing a target of a ResizeObserver.
+//
+// When the last target is unobserved, the observer is disconnected and its
+// Javascript handler is released.
+//
+// It accepts the following parameters:
+//   1. `observer` - the ResizeObserver.
+//   2. `target` - the observed Object.
+//
+// It shall returns:
+//   1. hestiaError.OK | `0` - operation successful.
+//   2. hestiaError.EOWNERDEAD - given `observer` is `nil`.
+//   3. hestiaError.EINVAL - given `target` is unusable.
+//   4. hestiaError.EBADE - given `target` is not observed.
+//   5. hestiaError.EPFNOSUPPORT | `96` - operating in a non-WASM CPU.
+func UnobserveResize(observer *ResizeObserver, target *Object) hestiaError.Error {
+	if observer == nil {
+		return hestiaError.EOWNERDEAD
+	}
+
+	return _unobserveObserver(&observer.observer, target)
+}
@@ -0,0 +1,539 @@
+// Copyright 2022 "Holloway" Chew, Kean Ho <hollowaykeanho@gmail.com>
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+//go:build wasm
+// +build wasm
+
+package hestiaWASM
+
+import (
+	"hestiaGo/hestiaError"
+	"syscall/js"
+)
+
+const (
+	resize_BOX_BORDER               = "border-box"
+	resize_BOX_CONTENT              = "content-box"
+	resize_BOX_DEVICE_PIXEL_CONTENT = "device-pixel-content-box"
+)
+
+func _disconnectObserver(observer *observerCore) hestiaError.Error {
+	observer.mutex.Lock()
+	defer observer.mutex.Unlock()
+
+	if observer.handler == nil {
+		return hestiaError.EBADE
+	}
+
+	__disconnectObserver(observer)
+
+	return hestiaError.OK
+}
+
+func _observeIntersection(observer *IntersectionObserver, target *Object) (err hestiaError.Error) {
+	var init map[string]any
+	var threshold []any
+	var i int
+
+	if IsObjectOK(target) != hestiaError.OK {
+		return hestiaError.EINVAL
+	}
+
+	// create the Javascript compatible constructor options
+	init = map[string]any{}
+
+	if IsObjectOK(observer.Root) == hestiaError.OK {
+		init[id_JS_OBSERVER_ROOT] = *(observer.Root.value)
+	}
+
+	if observer.RootMargin != "" {
+		init[id_JS_OBSERVER_ROOT_MARGIN] = observer.RootMargin
+	}
+
+	if observer.Threshold != nil {
+		threshold = make([]any, len(observer.Threshold))
+		for i = range observer.Threshold {
+			threshold[i] = observer.Threshold[i]
+		}
+
+		init[id_JS_OBSERVER_THRESHOLD] = threshold
+	}
+
+	observer.observer.mutex.Lock()
+	defer observer.observer.mutex.Unlock()
+
+	err = __initObserver(&observer.observer,
+		id_JS_INTERSECTION_OBSERVER,
+		init,
+		func(entries js.Value) {
+			list := __newIntersectionEntries(entries)
+			go observer.Function(list)
+		},
+	)
+	if err != hestiaError.OK {
+		return err
+	}
+
+	return __observe(&observer.observer, target, nil)
+}
+
+func _observeMutation(observer *MutationObserver,
+	target *Object,
+	options *MutationOptions) (err hestiaError.Error) {
+	var init map[string]any
+
+	if IsObjectOK(target) != hestiaError.OK {
+		return hestiaError.EINVAL
+	}
+
+	init = __newMutationOptions(options)
+
+	observer.observer.mutex.Lock()
+	defer observer.observer.mutex.Unlock()
+
+	err = __initObserver(&observer.observer,
+		id_JS_MUTATION_OBSERVER,
+		nil,
+		func(records js.Value) {
+			list := __newMutationRecords(records)
+			go observer.Function(list)
+		},
+	)
+	if err != hestiaError.OK {
+		return err
+	}
+
+	return __observe(&observer.observer, target, init)
+}
+
+func _observeResize(observer *ResizeObserver,
+	target *Object,
+	options *ResizeOptions) (err hestiaError.Error) {
+	var init map[string]any
+
+	if IsObjectOK(target) != hestiaError.OK {
+		return hestiaError.EINVAL
+	}
+
+	// create the Javascript compatible observe options
+	switch options.Box {
+	case RESIZE_BOX_CONTENT:
+		init = map[string]any{id_JS_OBSERVER_BOX: resize_BOX_CONTENT}
+	case RESIZE_BOX_BORDER:
+		init = map[string]any{id_JS_OBSERVER_BOX: resize_BOX_BORDER}
+	case RESIZE_BOX_DEVICE_PIXEL_CONTENT:
+		init = map[string]any{
+			id_JS_OBSERVER_BOX: resize_BOX_DEVICE_PIXEL_CONTENT,
+		}
+	default:
+		return hestiaError.EINVAL
+	}
+
+	observer.observer.mutex.Lock()
+	defer observer.observer.mutex.Unlock()
+
+	err = __initObserver(&observer.observer,
+		id_JS_RESIZE_OBSERVER,
+		nil,
+		func(entries js.Value) {
+			list := __newResizeEntries(entries)
+			go observer.Function(list)
+		},
+	)
+	if err != hestiaError.OK {
+		return err
+	}
+
+	return __observe(&observer.observer, target, init)
+}
+
+func _unobserveMutation(observer *MutationObserver, target *Object) hestiaError.Error {
+	var records js.Value
+	var list []*MutationRecord
+	var item *observerTarget
+	var i int
+
+	if IsObjectOK(target) != hestiaError.OK {
+		return hestiaError.EINVAL
+	}
+
+	observer.observer.mutex.Lock()
+	defer observer.observer.mutex.Unlock()
+
+	i = __findObserverTarget(&observer.observer, target)
+	if i < 0 {
+		return hestiaError.EBADE
+	}
+
+	// deliver pending records before disconnecting
+	records = observer.observer.handler.value.Call(id_JS_OBSERVER_TAKE_RECORDS)
+	if records.Get(id_JS_LENGTH).Int() > 0 {
+		list = __newMutationRecords(records)
+		go observer.Function(list)
+	}
+
+	observer.observer.targets = append(observer.observer.targets[:i],
+		observer.observer.targets[i+1:]...,
+	)
+
+	if len(observer.observer.targets) == 0 {
+		__disconnectObserver(&observer.observer)
+		return hestiaError.OK
+	}
+
+	// re-observe the remaining targets
+	observer.observer.handler.value.Call(id_JS_OBSERVER_DISCONNECT)
+	for _, item = range observer.observer.targets {
+		observer.observer.handler.value.Call(id_JS_OBSERVER_OBSERVE,
+			*(item.target.value),
+			item.options,
+		)
+	}
+
+	return hestiaError.OK
+}
+
+func _unobserveObserver(observer *observerCore, target *Object) hestiaError.Error {
+	var i int
+
+	if IsObjectOK(target) != hestiaError.OK {
+		return hestiaError.EINVAL
+	}
+
+	observer.mutex.Lock()
+	defer observer.mutex.Unlock()
+
+	i = __findObserverTarget(observer, target)
+	if i < 0 {
+		return hestiaError.EBADE
+	}
+
+	observer.handler.value.Call(id_JS_OBSERVER_UNOBSERVE, *(target.value))
+	observer.targets = append(observer.targets[:i], observer.targets[i+1:]...)
+
+	if len(observer.targets) == 0 {
+		__disconnectObserver(observer)
+	}
+
+	return hestiaError.OK
+}
+
+// NOTE: all functions below are sub-functions. Please use the global version
+// since it has proper guarding like `nil` object checking.
+
+func __disconnectObserver(observer *observerCore) {
+	observer.handler.value.Call(id_JS_OBSERVER_DISCONNECT)
+	__release(FUNC_KIND_OBSERVER, observer.handler.function)
+	observer.handler = nil
+	observer.targets = nil
+}
+
+func __findObserverTarget(observer *observerCore, target *Object) int {
+	var i int
+
+	for i = range observer.targets {
+		if observer.targets[i].target.value.Equal(*(target.value)) {
+			return i
+		}
+	}
+
+	return -1
+}
+
+func __initObserver(observer *observerCore,
+	constructor string,
+	init map[string]any,
+	callback func(js.Value)) (err hestiaError.Error) {
+	var global, ret js.Value
+	var handler js.Func
+
+	// observer is already running
+	if observer.handler != nil {
+		return hestiaError.OK
+	}
+
+	global = Global().value.Get(constructor)
+	if global.Type() != js.TypeFunction {
+		return hestiaError.EOPNOTSUPP
+	}
+
+	// create the Javascript compatible handler
+	handler = __funcOf(FUNC_KIND_OBSERVER, func(this js.Value, args []js.Value) any {
+		callback(args[0])
+
+		// return nothing since this is a JS function wrapper.
+		return nil
+	})
+
+	defer func() {
+		if r := recover(); r != nil {
+			__release(FUNC_KIND_OBSERVER, &handler)
+			err = hestiaError.EPROTO
+		}
+	}()
+
+	if init == nil {
+		ret = global.New(handler)
+	} else {
+		ret = global.New(handler, init)
+	}
+
+	// save function for later release
+	observer.handler = &Object{
+		value:    &ret,
+		function: &handler,
+	}
+
+	return hestiaError.OK
+}
+
+func __observe(observer *observerCore,
+	target *Object,
+	options map[string]any) (err hestiaError.Error) {
+	var i int
+
+	defer func() {
+		if r := recover(); r != nil {
+			// release the fresh observer if nothing is observed
+			if len(observer.targets) == 0 {
+				__disconnectObserver(observer)
+			}
+
+			err = hestiaError.EPROTO
+		}
+	}()
+
+	if options == nil {
+		observer.handler.value.Call(id_JS_OBSERVER_OBSERVE, *(target.value))
+	} else {
+		observer.handler.value.Call(id_JS_OBSERVER_OBSERVE,
+			*(target.value),
+			options,
+		)
+	}
+
+	// replace options for the same target
+	i = __findObserverTarget(observer, target)
+	if i >= 0 {
+		observer.targets[i].options = options
+		return hestiaError.OK
+	}
+
+	observer.targets = append(observer.targets, &observerTarget{
+		target:  target,
+		options: options,
+	})
+
+	return hestiaError.OK
+}
+
+func __newIntersectionEntries(entries js.Value) (out []*IntersectionEntry) {
+	var entry, bounds js.Value
+	var rect Rect
+	var i, length int
+
+	length = entries.Get(id_JS_LENGTH).Int()
+	out = make([]*IntersectionEntry, length)
+
+	for i = 0; i < length; i++ {
+		entry = entries.Index(i)
+		out[i] = &IntersectionEntry{
+			BoundingClientRect: __newRect(
+				entry.Get(id_JS_OBSERVER_BOUNDING_CLIENT_RECT),
+			),
+			IntersectionRect: __newRect(
+				entry.Get(id_JS_OBSERVER_INTERSECTION_RECT),
+			),
+			IntersectionRatio: entry.Get(
+				id_JS_OBSERVER_INTERSECTION_RATIO,
+			).Float(),
+			Time:           entry.Get(id_JS_OBSERVER_TIME).Float(),
+			IsIntersecting: entry.Get(id_JS_OBSERVER_IS_INTERSECTING).Bool(),
+		}
+		out[i].Target, _ = __toObject(entry.Get(id_JS_OBSERVER_TARGET))
+
+		bounds = entry.Get(id_JS_OBSERVER_ROOT_BOUNDS)
+		if bounds.Type() == js.TypeObject {
+			rect = __newRect(bounds)
+			out[i].RootBounds = &rect
+		}
+	}
+
+	return out
+}
+
+func __newMutationOptions(options *MutationOptions) (out map[string]any) {
+	var filter []any
+	var i int
+
+	// only set flags are passed since Javascript rejects explicit `false`
+	// mixed with their implying options.
+	out = map[string]any{}
+
+	if options.AttributeFilter != nil {
+		filter = make([]any, len(options.AttributeFilter))
+		for i = range options.AttributeFilter {
+			filter[i] = options.AttributeFilter[i]
+		}
+
+		out[id_JS_OBSERVER_ATTRIBUTE_FILTER] = filter
+	}
+
+	if options.Attributes {
+		out[id_JS_OBSERVER_ATTRIBUTES] = true
+	}
+
+	if options.AttributeOldValue {
+		out[id_JS_OBSERVER_ATTRIBUTE_OLD_VALUE] = true
+	}
+
+	if options.CharacterData {
+		out[id_JS_OBSERVER_CHARACTER_DATA] = true
+	}
+
+	if options.CharacterDataOldValue {
+		out[id_JS_OBSERVER_CHARACTER_DATA_OLD_VALUE] = true
+	}
+
+	if options.ChildList {
+		out[id_JS_OBSERVER_CHILD_LIST] = true
+	}
+
+	if options.Subtree {
+		out[id_JS_OBSERVER_SUBTREE] = true
+	}
+
+	return out
+}
+
+func __newMutationRecords(records js.Value) (out []*MutationRecord) {
+	var record js.Value
+	var i, length int
+
+	length = records.Get(id_JS_LENGTH).Int()
+	out = make([]*MutationRecord, length)
+
+	for i = 0; i < length; i++ {
+		record = records.Index(i)
+		out[i] = &MutationRecord{
+			Type: __stringOf(record.Get(id_JS_OBSERVER_TYPE)),
+			AddedNodes: __toObjectList(
+				record.Get(id_JS_OBSERVER_ADDED_NODES),
+			),
+			RemovedNodes: __toObjectList(
+				record.Get(id_JS_OBSERVER_REMOVED_NODES),
+			),
+			AttributeName: __stringOf(
+				record.Get(id_JS_OBSERVER_ATTRIBUTE_NAME),
+			),
+			AttributeNamespace: __stringOf(
+				record.Get(id_JS_OBSERVER_ATTRIBUTE_NAMESPACE),
+			),
+			OldValue: __stringOf(record.Get(id_JS_OBSERVER_OLD_VALUE)),
+		}
+		out[i].Target, _ = __toObject(record.Get(id_JS_OBSERVER_TARGET))
+		out[i].PreviousSibling, _ = __toObject(
+			record.Get(id_JS_OBSERVER_PREVIOUS_SIBLING),
+		)
+		out[i].NextSibling, _ = __toObject(
+			record.Get(id_JS_OBSERVER_NEXT_SIBLING),
+		)
+	}
+
+	return out
+}
+
+func __newRect(value js.Value) Rect {
+	return Rect{
+		X:      value.Get(id_JS_RECT_X).Float(),
+		Y:      value.Get(id_JS_RECT_Y).Float(),
+		Width:  value.Get(id_JS_RECT_WIDTH).Float(),
+		Height: value.Get(id_JS_RECT_HEIGHT).Float(),
+		Top:    value.Get(id_JS_RECT_TOP).Float(),
+		Right:  value.Get(id_JS_RECT_RIGHT).Float(),
+		Bottom: value.Get(id_JS_RECT_BOTTOM).Float(),
+		Left:   value.Get(id_JS_RECT_LEFT).Float(),
+	}
+}
+
+func __newResizeEntries(entries js.Value) (out []*ResizeEntry) {
+	var entry js.Value
+	var i, length int
+
+	length = entries.Get(id_JS_LENGTH).Int()
+	out = make([]*ResizeEntry, length)
+
+	for i = 0; i < length; i++ {
+		entry = entries.Index(i)
+		out[i] = &ResizeEntry{
+			ContentRect: __newRect(entry.Get(id_JS_OBSERVER_CONTENT_RECT)),
+			BorderBoxSize: __newResizeSizes(
+				entry.Get(id_JS_OBSERVER_BORDER_BOX_SIZE),
+			),
+			ContentBoxSize: __newResizeSizes(
+				entry.Get(id_JS_OBSERVER_CONTENT_BOX_SIZE),
+			),
+			DevicePixelContentBoxSize: __newResizeSizes(
+				entry.Get(id_JS_OBSERVER_DEVICE_PIXEL_CONTENT_BOX_SIZE),
+			),
+		}
+		out[i].Target, _ = __toObject(entry.Get(id_JS_OBSERVER_TARGET))
+	}
+
+	return out
+}
+
+func __newResizeSizes(sizes js.Value) (out []ResizeSize) {
+	var size js.Value
+	var i, length int
+
+	if sizes.Type() != js.TypeObject {
+		return nil
+	}
+
+	// older browsers report a single size instead of a list
+	if sizes.Get(id_JS_LENGTH).Type() != js.TypeNumber {
+		return []ResizeSize{{
+			InlineSize: sizes.Get(id_JS_OBSERVER_INLINE_SIZE).Float(),
+			BlockSize:  sizes.Get(id_JS_OBSERVER_BLOCK_SIZE).Float(),
+		}}
+	}
+
+	length = sizes.Get(id_JS_LENGTH).Int()
+	out = make([]ResizeSize, length)
+
+	for i = 0; i < length; i++ {
+		size = sizes.Index(i)
+		out[i] = ResizeSize{
+			InlineSize: size.Get(id_JS_OBSERVER_INLINE_SIZE).Float(),
+			BlockSize:  size.Get(id_JS_OBSERVER_BLOCK_SIZE).Float(),
+		}
+	}
+
+	return out
+}
+
+func __stringOf(value js.Value) string {
+	if value.Type() != js.TypeString {
+		return ""
+	}
+
+	return value.String()
+}
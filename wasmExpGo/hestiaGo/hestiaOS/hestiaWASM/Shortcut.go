@@ -0,0 +1,707 @@
+// Copyright 2022 "Holloway" Chew, Kean Ho <hollowaykeanho@gmail.com>
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package hestiaWASM
+
+import (
+	"hestiaGo/hestiaError"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	shortcut_CHORD_SEPARATOR = "+"
+	shortcut_EVENT           = "keydown"
+	shortcut_INPUT_SELECTOR  = "input, textarea, select, " +
+		"[contenteditable]:not([contenteditable=\"false\"])"
+	shortcut_SHIFT_DIGITS    = ")!@#$%^&*("
+	shortcut_TIMEOUT_DEFAULT = 1 * time.Second
+)
+
+// ShortcutPlatform is the platform deciding the `Mod` modifier key.
+type ShortcutPlatform uint8
+
+// ShortcutPlatform representations ID
+const (
+	// SHORTCUT_PLATFORM_AUTO detects the platform from the browser.
+	SHORTCUT_PLATFORM_AUTO ShortcutPlatform = 0
+
+	// SHORTCUT_PLATFORM_APPLE maps `Mod` to `Meta` (`Command`).
+	SHORTCUT_PLATFORM_APPLE ShortcutPlatform = 1
+
+	// SHORTCUT_PLATFORM_OTHER maps `Mod` to `Ctrl`.
+	SHORTCUT_PLATFORM_OTHER ShortcutPlatform = 2
+)
+
+// Shortcut is a keyboard shortcut for ShortcutManager.
+//
+// The Keys is a whitespace separated sequence of chords where each chord is
+// `+` separated modifiers ending with a key. Examples:
+//   1. `"Ctrl+S"` - a single chord.
+//   2. `"Mod+Shift+P"` - `Mod` is `Meta` on Apple platforms and `Ctrl` on
+//                        others.
+//   3. `"g i"` - a sequence of `g` followed by `i` within
+//                `ShortcutManager.Timeout`.
+//   4. `"Shift+Plus"` - use `Plus` and `Space` for `+` and ` ` keys.
+//
+// Recognized modifiers are `Ctrl` (`Control`), `Alt` (`Option`), `Shift`,
+// `Meta` (`Cmd`, `Command`, `Super`, `Win`), and `Mod`. Keys are matched
+// case-insensitively against `KeyboardEvent.Key` with `Esc`, `Del`, `Up`,
+// `Down`, `Left`, and `Right` aliases. When `Alt` or `Shift` changes a
+// letter or digit key (e.g. `Alt+S` gives `ß` on macOS, `Shift+1` gives `!`),
+// the physical `KeyboardEvent.Code` is matched as well.
+type Shortcut struct {
+	// Keys is the shortcut sequence.
+	Keys string
+
+	// Function is the function to execute when the shortcut is triggered.
+	//
+	// This function is executed in a separate goroutine.
+	Function func(*Event)
+
+	// Scope limits the shortcut to the focused subtree of the Object.
+	//
+	// When the focused element is inside multiple matching scopes, the
+	// deepest scope wins. Global shortcuts always lose against scoped ones.
+	//
+	// Default (`nil`) is global.
+	Scope *Object
+
+	// AllowInInput allows triggering when an editable element is focused
+	// (e.g. `<input>`, `<textarea>`, and `contenteditable`).
+	//
+	// Default (`false`) is to ignore editable elements to not interfere with
+	// typing.
+	AllowInInput bool
+
+	// PreventDefault prevents the browser's default action of the final
+	// chord when triggered (e.g. saving page for `Ctrl+S`).
+	PreventDefault bool
+}
+
+// ShortcutManager is the keyboard shortcut subsystem.
+//
+// It listens to `keydown` on its Target once and dispatches the matched
+// Shortcut. The matching is done synchronously inside the Javascript
+// callback (see `EventListener.Filter`) so it is kept lean.
+//
+// The zero value is ready for use:
+//       manager := &hestiaWASM.ShortcutManager{}
+type ShortcutManager struct {
+	// Target is the Object listening to the keyboard events.
+	//
+	// Default (`nil`) is `Document()`. It is only read by
+	// `StartShortcuts(...)`.
+	Target *Object
+
+	// Timeout is the maximum delay between chords of a sequence.
+	//
+	// Default (`0`) is 1 second.
+	Timeout time.Duration
+
+	// Platform decides the `Mod` modifier key.
+	//
+	// Default (`SHORTCUT_PLATFORM_AUTO`) detects from the browser. It is
+	// resolved once by the first `AddShortcut(...)` or
+	// `StartShortcuts(...)`.
+	Platform ShortcutPlatform
+
+	// bindings is the read-only []*shortcutBinding replaced as a whole so
+	// Filter never waits for the mutex.
+	bindings atomic.Value
+
+	// pending and lastTime are only touched by Filter while reset asks it
+	// to drop the pending sequence.
+	pending  []shortcutChord
+	lastTime float64
+	reset    int32
+
+	listener *EventListener
+	mutex    sync.Mutex
+}
+
+type shortcutBinding struct {
+	shortcut *Shortcut
+	chords   []shortcutChord
+}
+
+type shortcutChord struct {
+	key       string
+	code      string
+	modifiers EventModifiers
+	symbol    bool
+}
+
+// AddShortcut registers a Shortcut into a ShortcutManager.
+//
+// A conflict is when a registered Shortcut in the same Scope has the same
+// sequence, or either sequence is the beginning of the other (e.g. `"g"` and
+// `"g i"`) where the longer one can never be triggered. The shifted digit
+// symbols of the US layout are also compared as their physical keys (e.g.
+// `"!"` and `"Shift+1"`).
+//
+// It accepts the following parameters:
+//   1. `manager` - the ShortcutManager.
+//   2. `shortcut` - the Shortcut to register.
+//
+// It shall returns:
+//   1. hestiaError.OK | `0` - operation successful.
+//   2. hestiaError.EOWNERDEAD - given `manager` is `nil`.
+//   3. hestiaError.ENOMEDIUM - given `shortcut` is `nil` or has no Function.
+//   4. hestiaError.EILSEQ - given `shortcut` has malformed Keys.
+//   5. hestiaError.EBADE - given `shortcut` is already registered.
+//   6. hestiaError.EEXIST - given `shortcut` conflicts with a registered
+//                           Shortcut.
+func AddShortcut(manager *ShortcutManager, shortcut *Shortcut) hestiaError.Error {
+	var bindings, list []*shortcutBinding
+	var binding *shortcutBinding
+	var chords []shortcutChord
+	var err hestiaError.Error
+
+	if manager == nil {
+		return hestiaError.EOWNERDEAD
+	}
+
+	if shortcut == nil || shortcut.Function == nil {
+		return hestiaError.ENOMEDIUM
+	}
+
+	manager.mutex.Lock()
+	defer manager.mutex.Unlock()
+
+	__resolveShortcutPlatform(manager)
+
+	chords, err = __parseShortcut(shortcut.Keys, manager.Platform)
+	if err != hestiaError.OK {
+		return err
+	}
+
+	bindings, _ = manager.bindings.Load().([]*shortcutBinding)
+	for _, binding = range bindings {
+		if binding.shortcut == shortcut {
+			return hestiaError.EBADE
+		}
+
+		if !__isShortcutScopeEqual(binding.shortcut.Scope, shortcut.Scope) {
+			continue
+		}
+
+		if __isShortcutConflict(binding.chords, chords) {
+			return hestiaError.EEXIST
+		}
+	}
+
+	list = make([]*shortcutBinding, len(bindings), len(bindings)+1)
+	copy(list, bindings)
+	list = append(list, &shortcutBinding{
+		shortcut: shortcut,
+		chords:   chords,
+	})
+	manager.bindings.Store(list)
+
+	return hestiaError.OK
+}
+
+// RemoveShortcut unregisters a Shortcut from a ShortcutManager.
+//
+// It accepts the following parameters:
+//   1. `manager` - the ShortcutManager.
+//   2. `shortcut` - the registered Shortcut.
+//
+// It shall returns:
+//   1. hestiaError.OK | `0` - operation successful.
+//   2. hestiaError.EOWNERDEAD - given `manager` is `nil`.
+//   3. hestiaError.ENOMEDIUM - given `shortcut` is `nil`.
+//   4. hestiaError.EBADE - given `shortcut` is not registered.
+func RemoveShortcut(manager *ShortcutManager, shortcut *Shortcut) hestiaError.Error {
+	var bindings, list []*shortcutBinding
+	var i int
+
+	if manager == nil {
+		return hestiaError.EOWNERDEAD
+	}
+
+	if shortcut == nil {
+		return hestiaError.ENOMEDIUM
+	}
+
+	manager.mutex.Lock()
+	defer manager.mutex.Unlock()
+
+	bindings, _ = manager.bindings.Load().([]*shortcutBinding)
+	for i = range bindings {
+		if bindings[i].shortcut != shortcut {
+			continue
+		}
+
+		list = make([]*shortcutBinding, 0, len(bindings)-1)
+		list = append(list, bindings[:i]...)
+		list = append(list, bindings[i+1:]...)
+		manager.bindings.Store(list)
+		atomic.StoreInt32(&manager.reset, 1)
+
+		return hestiaError.OK
+	}
+
+	return hestiaError.EBADE
+}
+
+// StartShortcuts starts listening to keyboard events for a ShortcutManager.
+//
+// It accepts the following parameters:
+//   1. `manager` - the ShortcutManager.
+//
+// It shall returns:
+//   1. hestiaError.OK | `0` - operation successful.
+//   2. hestiaError.EOWNERDEAD - given `manager` is `nil`.
+//   3. hestiaError.EALREADY - given `manager` was already started.
+//   4. hestiaError.EPFNOSUPPORT | `96` - operating in a non-WASM CPU.
+//   5. any error from `AddEventListener(...)`.
+func StartShortcuts(manager *ShortcutManager) hestiaError.Error {
+	var listener *EventListener
+	var target *Object
+	var err hestiaError.Error
+
+	if manager == nil {
+		return hestiaError.EOWNERDEAD
+	}
+
+	manager.mutex.Lock()
+	defer manager.mutex.Unlock()
+
+	if manager.listener != nil {
+		return hestiaError.EALREADY
+	}
+
+	__resolveShortcutPlatform(manager)
+
+	target = manager.Target
+	if target == nil {
+		target = Document()
+	}
+
+	listener = &EventListener{
+		Name: shortcut_EVENT,
+		Function: func(e *Event) {
+			// all work is done synchronously in Filter.
+		},
+		Filter: func(e *Event) EventDecision {
+			return __filterShortcut(manager, e)
+		},
+	}
+
+	err = AddEventListener(target, listener)
+	if err != hestiaError.OK {
+		return err
+	}
+
+	manager.Target = target
+	manager.listener = listener
+
+	return hestiaError.OK
+}
+
+// StopShortcuts stops listening to keyboard events for a ShortcutManager.
+//
+// The registered Shortcuts are kept for the next `StartShortcuts(...)`.
+//
+// It accepts the following parameters:
+//   1. `manager` - the ShortcutManager.
+//
+// It shall returns:
+//   1. hestiaError.OK | `0` - operation successful.
+//   2. hestiaError.EOWNERDEAD - given `manager` is `nil`.
+//   3. hestiaError.EBADE - given `manager` was not started.
+//   4. any error from `RemoveEventListener(...)`.
+func StopShortcuts(manager *ShortcutManager) hestiaError.Error {
+	var err hestiaError.Error
+
+	if manager == nil {
+		return hestiaError.EOWNERDEAD
+	}
+
+	manager.mutex.Lock()
+	defer manager.mutex.Unlock()
+
+	if manager.listener == nil {
+		return hestiaError.EBADE
+	}
+
+	err = RemoveEventListener(manager.Target, manager.listener)
+	if err != hestiaError.OK {
+		return err
+	}
+
+	manager.listener = nil
+	atomic.StoreInt32(&manager.reset, 1)
+
+	return hestiaError.OK
+}
+
+// NOTE: all functions below are sub-functions. Please use the global version
+// since it has proper guarding like `nil` object checking.
+
+func __filterShortcut(manager *ShortcutManager, e *Event) EventDecision {
+	var bindings []*shortcutBinding
+	var chord shortcutChord
+	var matched *Shortcut
+	var timeout time.Duration
+	var ok bool
+
+	if e.Keyboard == nil || e.Keyboard.IsComposing {
+		return EVENT_DECISION_SKIP
+	}
+
+	chord, ok = __newShortcutChord(e.Keyboard)
+	if !ok {
+		return EVENT_DECISION_SKIP
+	}
+
+	// Filter only runs inside the Javascript callback so it never locks.
+	bindings, _ = manager.bindings.Load().([]*shortcutBinding)
+	if atomic.SwapInt32(&manager.reset, 0) != 0 {
+		manager.pending = nil
+	}
+
+	// expire unfinished sequence
+	timeout = manager.Timeout
+	if timeout == 0 {
+		timeout = shortcut_TIMEOUT_DEFAULT
+	}
+
+	if e.Timestamp-manager.lastTime > float64(timeout.Milliseconds()) {
+		manager.pending = nil
+	}
+	manager.lastTime = e.Timestamp
+
+	manager.pending = append(manager.pending, chord)
+	matched, ok = __matchShortcut(bindings, manager.pending, e)
+	if !ok {
+		// restart the sequence from the current chord
+		manager.pending = []shortcutChord{chord}
+		matched, ok = __matchShortcut(bindings, manager.pending, e)
+		if !ok {
+			manager.pending = nil
+			return EVENT_DECISION_SKIP
+		}
+	}
+
+	// wait for the next chord
+	if matched == nil {
+		return EVENT_DECISION_SKIP
+	}
+
+	manager.pending = nil
+	go matched.Function(e)
+
+	if matched.PreventDefault {
+		return EVENT_DECISION_PREVENT_DEFAULT | EVENT_DECISION_SKIP
+	}
+
+	return EVENT_DECISION_SKIP
+}
+
+func __isShortcutConflict(a []shortcutChord, b []shortcutChord) bool {
+	if __isShortcutPrefix(a, b) || __isShortcutPrefix(b, a) {
+		return true
+	}
+
+	// the Code fallback matches a shifted digit symbol to its digit key
+	a = __physicalShortcut(a)
+	b = __physicalShortcut(b)
+
+	return __isShortcutPrefix(a, b) || __isShortcutPrefix(b, a)
+}
+
+func __isShortcutEditable(e *Event) bool {
+	var verdict bool
+
+	if e.Target == nil {
+		return false
+	}
+
+	verdict, _ = Matches(e.Target, shortcut_INPUT_SELECTOR)
+
+	return verdict
+}
+
+func __isShortcutInScope(shortcut *Shortcut, e *Event) bool {
+	var verdict bool
+
+	if shortcut.Scope == nil {
+		return true
+	}
+
+	if e.Target == nil {
+		return false
+	}
+
+	verdict, _ = Contains(shortcut.Scope, e.Target)
+
+	return verdict
+}
+
+func __isShortcutPrefix(prefix []shortcutChord, chords []shortcutChord) bool {
+	var i int
+
+	if len(prefix) > len(chords) {
+		return false
+	}
+
+	for i = range prefix {
+		if !__isShortcutChordEqual(prefix[i], chords[i]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func __isShortcutChordEqual(a shortcutChord, b shortcutChord) bool {
+	// fall back to the physical key when a modifier changed the key value
+	if a.key != b.key {
+		switch {
+		case a.code != "" && a.code == b.key:
+		case b.code != "" && b.code == a.key:
+		default:
+			return false
+		}
+
+		return a.modifiers == b.modifiers
+	}
+
+	// symbols (e.g. `?`) are typed with Shift on most layouts.
+	if a.symbol || b.symbol {
+		a.modifiers.Shift = false
+		b.modifiers.Shift = false
+	}
+
+	return a.modifiers == b.modifiers
+}
+
+func __isShortcutScopeEqual(a *Object, b *Object) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+
+	return _isShortcutScopeEqual(a, b)
+}
+
+func __matchShortcut(bindings []*shortcutBinding,
+	pending []shortcutChord,
+	e *Event) (matched *Shortcut, ok bool) {
+	var binding *shortcutBinding
+	var shortcut *Shortcut
+	var editable, deeper bool
+
+	editable = __isShortcutEditable(e)
+
+	for _, binding = range bindings {
+		shortcut = binding.shortcut
+		if editable && !shortcut.AllowInInput {
+			continue
+		}
+
+		if !__isShortcutPrefix(pending, binding.chords) {
+			continue
+		}
+
+		if !__isShortcutInScope(shortcut, e) {
+			continue
+		}
+
+		// partially matched sequence
+		ok = true
+		if len(pending) != len(binding.chords) {
+			continue
+		}
+
+		// prefer the deepest scope
+		switch {
+		case matched == nil:
+			matched = shortcut
+		case matched.Scope == nil:
+			matched = shortcut
+		case shortcut.Scope != nil:
+			deeper, _ = Contains(matched.Scope, shortcut.Scope)
+			if deeper {
+				matched = shortcut
+			}
+		}
+	}
+
+	return matched, ok
+}
+
+func __newShortcutChord(e *KeyboardEvent) (chord shortcutChord, ok bool) {
+	chord.key = __normalizeShortcutKey(e.Key)
+
+	switch chord.key {
+	case "", "control", "alt", "shift", "meta", "os", "altgraph",
+		"dead", "unidentified", "process":
+		return chord, false
+	}
+
+	chord.modifiers = e.Modifiers
+	chord.symbol = __isShortcutSymbol(chord.key)
+
+	if chord.modifiers.Alt || chord.modifiers.Shift {
+		chord.code = __codeShortcutKey(e.Code)
+		if chord.code == chord.key {
+			chord.code = ""
+		}
+	}
+
+	return chord, true
+}
+
+func __codeShortcutKey(code string) string {
+	switch {
+	case len(code) == 4 && strings.HasPrefix(code, "Key"):
+		return strings.ToLower(code[3:])
+	case len(code) == 6 && strings.HasPrefix(code, "Digit"):
+		return code[5:]
+	}
+
+	return ""
+}
+
+func __isShortcutSymbol(key string) bool {
+	var c byte
+
+	if len(key) != 1 {
+		return key == "plus"
+	}
+
+	c = key[0]
+
+	return !(c >= 'a' && c <= 'z') && !(c >= '0' && c <= '9')
+}
+
+func __normalizeShortcutKey(key string) string {
+	if key == " " {
+		return "space"
+	}
+
+	key = strings.ToLower(key)
+	switch key {
+	case "+":
+		return "plus"
+	case "esc":
+		return "escape"
+	case "del":
+		return "delete"
+	case "up", "down", "left", "right":
+		return "arrow" + key
+	case "spacebar":
+		return "space"
+	}
+
+	return key
+}
+
+func __parseShortcut(keys string, platform ShortcutPlatform) (out []shortcutChord, err hestiaError.Error) {
+	var parts, tokens []string
+	var chord shortcutChord
+	var part, token string
+	var i int
+
+	parts = strings.Fields(keys)
+	if len(parts) == 0 {
+		return nil, hestiaError.EILSEQ
+	}
+
+	out = make([]shortcutChord, 0, len(parts))
+	for _, part = range parts {
+		chord = shortcutChord{}
+
+		tokens = strings.Split(part, shortcut_CHORD_SEPARATOR)
+		for i, token = range tokens {
+			if token == "" {
+				return nil, hestiaError.EILSEQ
+			}
+
+			// last token is the key
+			if i == len(tokens)-1 {
+				chord.key = __normalizeShortcutKey(token)
+				chord.symbol = __isShortcutSymbol(chord.key)
+				break
+			}
+
+			switch strings.ToLower(token) {
+			case "ctrl", "control":
+				chord.modifiers.Ctrl = true
+			case "alt", "option":
+				chord.modifiers.Alt = true
+			case "shift":
+				chord.modifiers.Shift = true
+			case "meta", "cmd", "command", "super", "win":
+				chord.modifiers.Meta = true
+			case "mod":
+				if platform == SHORTCUT_PLATFORM_APPLE {
+					chord.modifiers.Meta = true
+				} else {
+					chord.modifiers.Ctrl = true
+				}
+			default:
+				return nil, hestiaError.EILSEQ
+			}
+		}
+
+		out = append(out, chord)
+	}
+
+	return out, hestiaError.OK
+}
+
+func __physicalShortcut(chords []shortcutChord) (out []shortcutChord) {
+	var digit, i int
+
+	out = make([]shortcutChord, len(chords))
+	copy(out, chords)
+
+	for i = range out {
+		if len(out[i].key) != 1 {
+			continue
+		}
+
+		digit = strings.Index(shortcut_SHIFT_DIGITS, out[i].key)
+		if digit < 0 {
+			continue
+		}
+
+		out[i].key = strconv.Itoa(digit)
+		out[i].modifiers.Shift = true
+		out[i].symbol = false
+	}
+
+	return out
+}
+
+func __resolveShortcutPlatform(manager *ShortcutManager) {
+	if manager.Platform != SHORTCUT_PLATFORM_AUTO {
+		return
+	}
+
+	manager.Platform = _shortcutPlatform()
+}
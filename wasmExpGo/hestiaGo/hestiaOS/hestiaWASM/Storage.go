@@ -0,0 +1,392 @@
+// Copyright 2022 "Holloway" Chew, Kean Ho <hollowaykeanho@gmail.com>
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package hestiaWASM
+
+import (
+	"encoding/json"
+	"hestiaGo/hestiaError"
+	"sync"
+)
+
+// StorageArea is the Javascript Web Storage area.
+type StorageArea uint8
+
+const (
+	// STORAGE_LOCAL is the persistent `localStorage`.
+	STORAGE_LOCAL StorageArea = 0
+
+	// STORAGE_SESSION is the per-tab `sessionStorage`.
+	STORAGE_SESSION StorageArea = 1
+
+	storage_AREA_MAX StorageArea = 2
+)
+
+// Storage is the hestiaWASM key-value storage.
+//
+// It is implemented over Javascript `localStorage` and `sessionStorage` in
+// wasm builds. In other builds, it is implemented over an in-memory store
+// shared by all Storage with the same Area and Path within the process. When
+// Path is set for STORAGE_LOCAL, the store is persisted into that JSON file.
+//
+// Like Javascript, the change notifications are only received for changes
+// made by others (e.g. other browser tabs or other Storage sharing the same
+// store in non-wasm builds). Use `StorageWatch(...)` to start receiving them.
+type Storage struct {
+	// OnChange is the function receiving the storage changes.
+	//
+	// This function is executed in a separate goroutine receiving the
+	// changes one by one in their order.
+	OnChange func(change *StorageChange)
+
+	// Path is the JSON file persisting STORAGE_LOCAL in non-wasm builds.
+	//
+	// Default (`""`) keeps the data in memory only. It is not used in wasm
+	// builds.
+	Path string
+
+	// Quota is the maximum bytes of all keys and values in non-wasm builds.
+	//
+	// Default (`0`) is 5 MiB like most browsers. It is not used in wasm builds
+	// since the browser enforces its own quota.
+	Quota int
+
+	// Area is the storage area.
+	//
+	// Default (`0`) is STORAGE_LOCAL.
+	Area StorageArea
+
+	watcher *storageWatcher
+	mutex   sync.Mutex
+}
+
+// StorageChange is the Go format of Javascript StorageEvent.
+type StorageChange struct {
+	// Key is the changed key. It is empty when IsCleared is `true`.
+	Key string
+
+	// OldValue is the value before the change. It is empty when the key was
+	// newly added.
+	OldValue string
+
+	// NewValue is the value after the change. It is empty when the key was
+	// deleted.
+	NewValue string
+
+	// URL is the address of the page making the change (wasm builds only).
+	URL string
+
+	// IsCleared states the whole storage was cleared.
+	IsCleared bool
+}
+
+// storageQueue delivers the changes of a watcher in order from a goroutine
+// that only lives while there are changes.
+type storageQueue struct {
+	fx      func(*StorageChange)
+	list    []*StorageChange
+	mutex   sync.Mutex
+	running bool
+}
+
+// StorageClear deletes all the keys in a Storage.
+//
+// It accepts the following parameters:
+//   1. `s` - the Storage.
+//
+// It shall returns:
+//   1. hestiaError.OK | `0` - operation successful.
+//   2. hestiaError.EOWNERDEAD - given `s` is `nil`.
+//   3. hestiaError.EINVAL - given `s` has an unknown Area.
+//   4. hestiaError.EACCES - the storage is disabled or inaccessible.
+//   5. hestiaError.EIO - failed to persist the storage (non-wasm).
+func StorageClear(s *Storage) hestiaError.Error {
+	var err hestiaError.Error
+
+	err = __checkStorage(s)
+	if err != hestiaError.OK {
+		return err
+	}
+
+	return _storageClear(s)
+}
+
+// StorageDelete deletes a key from a Storage.
+//
+// Deleting a missing key is not an error.
+//
+// It accepts the following parameters:
+//   1. `s` - the Storage.
+//   2. `key` - the key to delete.
+//
+// It shall returns:
+//   1. hestiaError.OK | `0` - operation successful.
+//   2. hestiaError.EOWNERDEAD - given `s` is `nil`.
+//   3. hestiaError.EINVAL - given `s` has an unknown Area.
+//   4. hestiaError.EACCES - the storage is disabled or inaccessible.
+//   5. hestiaError.EIO - failed to persist the storage (non-wasm).
+func StorageDelete(s *Storage, key string) hestiaError.Error {
+	var err hestiaError.Error
+
+	err = __checkStorage(s)
+	if err != hestiaError.OK {
+		return err
+	}
+
+	return _storageDelete(s, key)
+}
+
+// StorageGet reads the value of a key from a Storage.
+//
+// It accepts the following parameters:
+//   1. `s` - the Storage.
+//   2. `key` - the key to read.
+//
+// It shall returns:
+//   1. value, hestiaError.OK | `0` - operation successful.
+//   2. `""`, hestiaError.EOWNERDEAD - given `s` is `nil`.
+//   3. `""`, hestiaError.EINVAL - given `s` has an unknown Area.
+//   4. `""`, hestiaError.ENOENT - given `key` does not exist.
+//   5. `""`, hestiaError.EACCES - the storage is disabled or inaccessible.
+//   6. `""`, hestiaError.EBADMSG - the persisted file is corrupted (non-wasm).
+func StorageGet(s *Storage, key string) (string, hestiaError.Error) {
+	var err hestiaError.Error
+
+	err = __checkStorage(s)
+	if err != hestiaError.OK {
+		return "", err
+	}
+
+	return _storageGet(s, key)
+}
+
+// StorageGetJSON reads the JSON value of a key into a Go value.
+//
+// It accepts the following parameters:
+//   1. `s` - the Storage.
+//   2. `key` - the key to read.
+//   3. `out` - the pointer to the Go value for `json.Unmarshal(...)`.
+//
+// It shall returns:
+//   1. hestiaError.OK | `0` - operation successful.
+//   2. hestiaError.EBADMSG - the value is not a valid JSON for `out`.
+//   3. Any error from `StorageGet(...)`.
+func StorageGetJSON(s *Storage, key string, out any) hestiaError.Error {
+	var value string
+	var err hestiaError.Error
+
+	value, err = StorageGet(s, key)
+	if err != hestiaError.OK {
+		return err
+	}
+
+	if json.Unmarshal([]byte(value), out) != nil {
+		return hestiaError.EBADMSG
+	}
+
+	return hestiaError.OK
+}
+
+// StorageKeys lists all the keys in a Storage.
+//
+// The keys are in the backend's order which is not guaranteed to be stable.
+//
+// It accepts the following parameters:
+//   1. `s` - the Storage.
+//
+// It shall returns:
+//   1. []string, hestiaError.OK | `0` - operation successful.
+//   2. `nil`, hestiaError.EOWNERDEAD - given `s` is `nil`.
+//   3. `nil`, hestiaError.EINVAL - given `s` has an unknown Area.
+//   4. `nil`, hestiaError.EACCES - the storage is disabled or inaccessible.
+//   5. `nil`, hestiaError.EBADMSG - the persisted file is corrupted
+//                                   (non-wasm).
+func StorageKeys(s *Storage) ([]string, hestiaError.Error) {
+	var err hestiaError.Error
+
+	err = __checkStorage(s)
+	if err != hestiaError.OK {
+		return nil, err
+	}
+
+	return _storageKeys(s)
+}
+
+// StorageSet writes the value of a key into a Storage.
+//
+// It accepts the following parameters:
+//   1. `s` - the Storage.
+//   2. `key` - the key to write.
+//   3. `value` - the value to write.
+//
+// It shall returns:
+//   1. hestiaError.OK | `0` - operation successful.
+//   2. hestiaError.EOWNERDEAD - given `s` is `nil`.
+//   3. hestiaError.EINVAL - given `s` has an unknown Area.
+//   4. hestiaError.ENOSPC - the storage quota is exceeded.
+//   5. hestiaError.EACCES - the storage is disabled or inaccessible.
+//   6. hestiaError.EIO - failed to persist the storage (non-wasm).
+func StorageSet(s *Storage, key string, value string) hestiaError.Error {
+	var err hestiaError.Error
+
+	err = __checkStorage(s)
+	if err != hestiaError.OK {
+		return err
+	}
+
+	return _storageSet(s, key, value)
+}
+
+// StorageSetJSON writes a Go value as JSON into a Storage.
+//
+// It accepts the following parameters:
+//   1. `s` - the Storage.
+//   2. `key` - the key to write.
+//   3. `value` - the Go value for `json.Marshal(...)`.
+//
+// It shall returns:
+//   1. hestiaError.OK | `0` - operation successful.
+//   2. hestiaError.EILSEQ - given `value` cannot be encoded into JSON.
+//   3. Any error from `StorageSet(...)`.
+func StorageSetJSON(s *Storage, key string, value any) hestiaError.Error {
+	var data []byte
+	var ret error
+
+	data, ret = json.Marshal(value)
+	if ret != nil {
+		return hestiaError.EILSEQ
+	}
+
+	return StorageSet(s, key, string(data))
+}
+
+// StorageUnwatch stops receiving the change notifications of a Storage.
+//
+// It accepts the following parameters:
+//   1. `s` - the Storage.
+//
+// It shall returns:
+//   1. hestiaError.OK | `0` - operation successful.
+//   2. hestiaError.EOWNERDEAD - given `s` is `nil`.
+//   3. hestiaError.ENOENT - given `s` is not watching.
+func StorageUnwatch(s *Storage) hestiaError.Error {
+	if s == nil {
+		return hestiaError.EOWNERDEAD
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if s.watcher == nil {
+		return hestiaError.ENOENT
+	}
+
+	_storageUnwatch(s)
+	s.watcher = nil
+
+	return hestiaError.OK
+}
+
+// StorageWatch starts receiving the change notifications of a Storage.
+//
+// The Javascript `storage` event listener is kept until
+// `StorageUnwatch(...)` is called.
+//
+// It accepts the following parameters:
+//   1. `s` - the Storage.
+//
+// It shall returns:
+//   1. hestiaError.OK | `0` - operation successful.
+//   2. hestiaError.EOWNERDEAD - given `s` is `nil`.
+//   3. hestiaError.EINVAL - given `s` has an unknown Area.
+//   4. hestiaError.ENOENT - given `s` has no OnChange.
+//   5. hestiaError.EALREADY - given `s` is already watching.
+//   6. hestiaError.EACCES - the storage is disabled or inaccessible.
+func StorageWatch(s *Storage) hestiaError.Error {
+	var err hestiaError.Error
+
+	err = __checkStorage(s)
+	if err != hestiaError.OK {
+		return err
+	}
+
+	if s.OnChange == nil {
+		return hestiaError.ENOENT
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if s.watcher != nil {
+		return hestiaError.EALREADY
+	}
+
+	s.watcher, err = _storageWatch(s)
+
+	return err
+}
+
+// NOTE: all functions below are sub-functions. Please use the global version
+// since it has proper guarding like `nil` object checking.
+
+func __checkStorage(s *Storage) hestiaError.Error {
+	if s == nil {
+		return hestiaError.EOWNERDEAD
+	}
+
+	if s.Area >= storage_AREA_MAX {
+		return hestiaError.EINVAL
+	}
+
+	return hestiaError.OK
+}
+
+func __pumpStorage(queue *storageQueue) {
+	var change *StorageChange
+
+	for {
+		queue.mutex.Lock()
+		if len(queue.list) == 0 {
+			queue.running = false
+			queue.mutex.Unlock()
+			return
+		}
+
+		change = queue.list[0]
+		queue.list[0] = nil
+		queue.list = queue.list[1:]
+		queue.mutex.Unlock()
+
+		queue.fx(change)
+	}
+}
+
+func __queueStorage(queue *storageQueue, change *StorageChange) {
+	queue.mutex.Lock()
+	defer queue.mutex.Unlock()
+
+	queue.list = append(queue.list, change)
+	if queue.running {
+		return
+	}
+
+	queue.running = true
+	go __pumpStorage(queue)
+}
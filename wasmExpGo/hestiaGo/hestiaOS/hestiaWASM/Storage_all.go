@@ -0,0 +1,410 @@
+// Copyright 2022 "Holloway" Chew, Kean Ho <hollowaykeanho@gmail.com>
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+//go:build !wasm
+// +build !wasm
+
+package hestiaWASM
+
+import (
+	"encoding/json"
+	"errors"
+	"hestiaGo/hestiaError"
+	"os"
+	"path/filepath"
+	"sync"
+	"syscall"
+)
+
+const (
+	storage_FILE_MODE     = 0600
+	storage_QUOTA_DEFAULT = 5 << 20
+)
+
+type storageWatcher struct {
+	backend *storageBackend
+	storage *Storage
+	queue   *storageQueue
+}
+
+type storageBackend struct {
+	data     map[string]string
+	watchers []*storageWatcher
+	path     string
+	size     int
+	mutex    sync.Mutex
+	loaded   bool
+}
+
+var storageBackends = struct {
+	list  map[string]*storageBackend
+	mutex sync.Mutex
+}{
+	list: map[string]*storageBackend{},
+}
+
+func _storageClear(s *Storage) (err hestiaError.Error) {
+	var backend *storageBackend
+	var old map[string]string
+
+	backend, err = __storageBackend(s)
+	if err != hestiaError.OK {
+		return err
+	}
+
+	backend.mutex.Lock()
+	defer backend.mutex.Unlock()
+
+	if len(backend.data) == 0 {
+		return hestiaError.OK
+	}
+
+	old = backend.data
+	backend.data = map[string]string{}
+	backend.size = 0
+
+	err = __saveStorage(backend)
+	if err != hestiaError.OK {
+		backend.data = old
+		backend.size = __sizeStorage(old)
+		return err
+	}
+
+	__notifyStorage(backend, s, &StorageChange{IsCleared: true})
+
+	return hestiaError.OK
+}
+
+func _storageDelete(s *Storage, key string) (err hestiaError.Error) {
+	var backend *storageBackend
+	var value string
+	var ok bool
+
+	backend, err = __storageBackend(s)
+	if err != hestiaError.OK {
+		return err
+	}
+
+	backend.mutex.Lock()
+	defer backend.mutex.Unlock()
+
+	value, ok = backend.data[key]
+	if !ok {
+		return hestiaError.OK
+	}
+
+	delete(backend.data, key)
+	backend.size -= len(key) + len(value)
+
+	err = __saveStorage(backend)
+	if err != hestiaError.OK {
+		backend.data[key] = value
+		backend.size += len(key) + len(value)
+		return err
+	}
+
+	__notifyStorage(backend, s, &StorageChange{
+		Key:      key,
+		OldValue: value,
+	})
+
+	return hestiaError.OK
+}
+
+func _storageGet(s *Storage, key string) (string, hestiaError.Error) {
+	var backend *storageBackend
+	var value string
+	var ok bool
+	var err hestiaError.Error
+
+	backend, err = __storageBackend(s)
+	if err != hestiaError.OK {
+		return "", err
+	}
+
+	backend.mutex.Lock()
+	defer backend.mutex.Unlock()
+
+	value, ok = backend.data[key]
+	if !ok {
+		return "", hestiaError.ENOENT
+	}
+
+	return value, hestiaError.OK
+}
+
+func _storageKeys(s *Storage) ([]string, hestiaError.Error) {
+	var backend *storageBackend
+	var list []string
+	var key string
+	var err hestiaError.Error
+
+	backend, err = __storageBackend(s)
+	if err != hestiaError.OK {
+		return nil, err
+	}
+
+	backend.mutex.Lock()
+	defer backend.mutex.Unlock()
+
+	list = make([]string, 0, len(backend.data))
+	for key = range backend.data {
+		list = append(list, key)
+	}
+
+	return list, hestiaError.OK
+}
+
+func _storageSet(s *Storage, key string, value string) (err hestiaError.Error) {
+	var backend *storageBackend
+	var old string
+	var quota, size int
+	var ok bool
+
+	backend, err = __storageBackend(s)
+	if err != hestiaError.OK {
+		return err
+	}
+
+	quota = s.Quota
+	if quota <= 0 {
+		quota = storage_QUOTA_DEFAULT
+	}
+
+	backend.mutex.Lock()
+	defer backend.mutex.Unlock()
+
+	old, ok = backend.data[key]
+	if ok && old == value {
+		return hestiaError.OK
+	}
+
+	size = backend.size + len(value)
+	if ok {
+		size -= len(old)
+	} else {
+		size += len(key)
+	}
+
+	if size > quota {
+		return hestiaError.ENOSPC
+	}
+
+	backend.data[key] = value
+	err = __saveStorage(backend)
+	if err != hestiaError.OK {
+		if ok {
+			backend.data[key] = old
+		} else {
+			delete(backend.data, key)
+		}
+
+		return err
+	}
+	backend.size = size
+
+	__notifyStorage(backend, s, &StorageChange{
+		Key:      key,
+		OldValue: old,
+		NewValue: value,
+	})
+
+	return hestiaError.OK
+}
+
+func _storageUnwatch(s *Storage) {
+	var backend *storageBackend
+	var i int
+
+	backend = s.watcher.backend
+
+	backend.mutex.Lock()
+	defer backend.mutex.Unlock()
+
+	for i = range backend.watchers {
+		if backend.watchers[i] != s.watcher {
+			continue
+		}
+
+		backend.watchers = append(backend.watchers[:i],
+			backend.watchers[i+1:]...,
+		)
+
+		return
+	}
+}
+
+func _storageWatch(s *Storage) (*storageWatcher, hestiaError.Error) {
+	var watcher *storageWatcher
+	var backend *storageBackend
+	var err hestiaError.Error
+
+	backend, err = __storageBackend(s)
+	if err != hestiaError.OK {
+		return nil, err
+	}
+
+	watcher = &storageWatcher{
+		backend: backend,
+		storage: s,
+		queue:   &storageQueue{fx: s.OnChange},
+	}
+
+	backend.mutex.Lock()
+	backend.watchers = append(backend.watchers, watcher)
+	backend.mutex.Unlock()
+
+	return watcher, hestiaError.OK
+}
+
+// NOTE: all functions below are sub-functions. Please use the global version
+// since it has proper guarding like `nil` object checking.
+
+func __loadStorage(backend *storageBackend) hestiaError.Error {
+	var list map[string]string
+	var data []byte
+	var ret error
+
+	if backend.path == "" {
+		return hestiaError.OK
+	}
+
+	data, ret = os.ReadFile(backend.path)
+	switch {
+	case errors.Is(ret, os.ErrNotExist):
+		return hestiaError.OK
+	case errors.Is(ret, os.ErrPermission):
+		return hestiaError.EACCES
+	case ret != nil:
+		return hestiaError.EIO
+	}
+
+	if json.Unmarshal(data, &list) != nil {
+		return hestiaError.EBADMSG
+	}
+
+	if list != nil {
+		backend.data = list
+	}
+	backend.size = __sizeStorage(backend.data)
+
+	return hestiaError.OK
+}
+
+func __notifyStorage(backend *storageBackend, origin *Storage, change *StorageChange) {
+	var watcher *storageWatcher
+
+	// like Javascript, the changing Storage is not notified
+	for _, watcher = range backend.watchers {
+		if watcher.storage == origin {
+			continue
+		}
+
+		__queueStorage(watcher.queue, change)
+	}
+}
+
+func __saveStorage(backend *storageBackend) hestiaError.Error {
+	var data []byte
+	var temp string
+	var ret error
+
+	if backend.path == "" {
+		return hestiaError.OK
+	}
+
+	data, ret = json.Marshal(backend.data)
+	if ret != nil {
+		return hestiaError.EIO
+	}
+
+	// write atomically so a crash never leaves a corrupted file behind
+	temp = backend.path + ".tmp"
+
+	ret = os.MkdirAll(filepath.Dir(backend.path), 0700)
+	if ret == nil {
+		ret = os.WriteFile(temp, data, storage_FILE_MODE)
+	}
+
+	if ret == nil {
+		ret = os.Rename(temp, backend.path)
+	}
+
+	switch {
+	case ret == nil:
+		return hestiaError.OK
+	case errors.Is(ret, syscall.ENOSPC):
+		return hestiaError.ENOSPC
+	case errors.Is(ret, os.ErrPermission):
+		return hestiaError.EACCES
+	default:
+		return hestiaError.EIO
+	}
+}
+
+func __sizeStorage(data map[string]string) (size int) {
+	var key, value string
+
+	for key, value = range data {
+		size += len(key) + len(value)
+	}
+
+	return size
+}
+
+func __storageBackend(s *Storage) (*storageBackend, hestiaError.Error) {
+	var backend *storageBackend
+	var path, id string
+	var ok bool
+	var err hestiaError.Error
+
+	// session storage never outlives the process
+	if s.Area == STORAGE_LOCAL && s.Path != "" {
+		path, _ = filepath.Abs(s.Path)
+	}
+
+	id = string(rune('0'+s.Area)) + path
+
+	storageBackends.mutex.Lock()
+	backend, ok = storageBackends.list[id]
+	if !ok {
+		backend = &storageBackend{
+			data: map[string]string{},
+			path: path,
+		}
+		storageBackends.list[id] = backend
+	}
+	storageBackends.mutex.Unlock()
+
+	backend.mutex.Lock()
+	defer backend.mutex.Unlock()
+
+	// retry loading on each access until the file is readable
+	if !backend.loaded {
+		err = __loadStorage(backend)
+		if err != hestiaError.OK {
+			return nil, err
+		}
+
+		backend.loaded = true
+	}
+
+	return backend, hestiaError.OK
+}
@@ -0,0 +1,238 @@
+// Copyright 2022 "Holloway" Chew, Kean Ho <hollowaykeanho@gmail.com>
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+//go:build !wasm
+// +build !wasm
+
+package hestiaWASM
+
+import (
+	"encoding/json"
+	"hestiaGo/hestiaError"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+const (
+	storage_TEST_TIMEOUT = 3 * time.Second
+)
+
+func TestStorageQuota(t *testing.T) {
+	var s *Storage
+	var value string
+	var err hestiaError.Error
+
+	s = &Storage{
+		Path:  filepath.Join(t.TempDir(), "quota.json"),
+		Quota: 16,
+	}
+
+	// a key and its value are both counted
+	err = StorageSet(s, "k", "12345")
+	if err != hestiaError.OK {
+		t.Fatalf("StorageSet(...) = %v, want OK", err)
+	}
+
+	err = StorageSet(s, "big", "12345678901234")
+	if err != hestiaError.ENOSPC {
+		t.Fatalf("StorageSet(...) over quota = %v, want ENOSPC", err)
+	}
+
+	_, err = StorageGet(s, "big")
+	if err != hestiaError.ENOENT {
+		t.Fatalf("StorageGet(...) rejected key = %v, want ENOENT", err)
+	}
+
+	// replacing a value only counts the difference
+	err = StorageSet(s, "k", "123456789012345")
+	if err != hestiaError.OK {
+		t.Fatalf("StorageSet(...) replacing = %v, want OK", err)
+	}
+
+	value, err = StorageGet(s, "k")
+	if err != hestiaError.OK || value != "123456789012345" {
+		t.Fatalf("StorageGet(...) = %q, %v, want %q",
+			value, err, "123456789012345")
+	}
+}
+
+func TestStorageChangeNotification(t *testing.T) {
+	var writer, reader *Storage
+	var changes chan *StorageChange
+	var own chan *StorageChange
+	var change *StorageChange
+	var want []StorageChange
+	var path string
+	var i int
+	var err hestiaError.Error
+
+	path = filepath.Join(t.TempDir(), "watch.json")
+	changes = make(chan *StorageChange, 8)
+	own = make(chan *StorageChange, 8)
+
+	writer = &Storage{
+		Path: path,
+		OnChange: func(change *StorageChange) {
+			own <- change
+		},
+	}
+
+	reader = &Storage{
+		Path: path,
+		OnChange: func(change *StorageChange) {
+			changes <- change
+		},
+	}
+
+	err = StorageWatch(writer)
+	if err != hestiaError.OK {
+		t.Fatalf("StorageWatch(writer) = %v, want OK", err)
+	}
+	defer StorageUnwatch(writer)
+
+	err = StorageWatch(reader)
+	if err != hestiaError.OK {
+		t.Fatalf("StorageWatch(reader) = %v, want OK", err)
+	}
+
+	err = StorageWatch(reader)
+	if err != hestiaError.EALREADY {
+		t.Fatalf("StorageWatch(...) again = %v, want EALREADY", err)
+	}
+
+	_ = StorageSet(writer, "a", "1")
+	_ = StorageSet(writer, "a", "2")
+	_ = StorageDelete(writer, "a")
+	_ = StorageSet(writer, "b", "3")
+	_ = StorageClear(writer)
+
+	want = []StorageChange{
+		{Key: "a", NewValue: "1"},
+		{Key: "a", OldValue: "1", NewValue: "2"},
+		{Key: "a", OldValue: "2"},
+		{Key: "b", NewValue: "3"},
+		{IsCleared: true},
+	}
+
+	// the changes arrive in their order
+	for i = range want {
+		select {
+		case change = <-changes:
+		case <-time.After(storage_TEST_TIMEOUT):
+			t.Fatalf("change %d was not received", i)
+		}
+
+		if *change != want[i] {
+			t.Fatalf("change %d = %+v, want %+v", i, *change, want[i])
+		}
+	}
+
+	// like Javascript, the changing Storage is not notified
+	select {
+	case change = <-own:
+		t.Fatalf("writer received its own change %+v", *change)
+	default:
+	}
+
+	err = StorageUnwatch(reader)
+	if err != hestiaError.OK {
+		t.Fatalf("StorageUnwatch(...) = %v, want OK", err)
+	}
+
+	_ = StorageSet(writer, "c", "4")
+
+	select {
+	case change = <-changes:
+		t.Fatalf("unwatched reader received %+v", *change)
+	case <-time.After(50 * time.Millisecond):
+	}
+}
+
+func TestStoragePersistence(t *testing.T) {
+	var s *Storage
+	var list map[string]string
+	var keys []string
+	var data []byte
+	var directory, value string
+	var number int
+	var err hestiaError.Error
+	var ret error
+
+	directory = t.TempDir()
+	s = &Storage{Path: filepath.Join(directory, "saved.json")}
+
+	_ = StorageSet(s, "name", "hestia")
+	_ = StorageSetJSON(s, "number", 42)
+
+	// every change is saved into the file
+	data, ret = os.ReadFile(s.Path)
+	if ret != nil {
+		t.Fatalf("ReadFile(...) = %v", ret)
+	}
+
+	ret = json.Unmarshal(data, &list)
+	if ret != nil || list["name"] != "hestia" || list["number"] != "42" {
+		t.Fatalf("saved file = %s, %v", data, ret)
+	}
+
+	// an existing file is loaded on first use
+	ret = os.WriteFile(filepath.Join(directory, "loaded.json"),
+		[]byte(`{"name":"loaded","number":"7"}`),
+		storage_FILE_MODE,
+	)
+	if ret != nil {
+		t.Fatalf("WriteFile(...) = %v", ret)
+	}
+
+	s = &Storage{Path: filepath.Join(directory, "loaded.json")}
+
+	value, err = StorageGet(s, "name")
+	if err != hestiaError.OK || value != "loaded" {
+		t.Fatalf("StorageGet(...) = %q, %v, want %q", value, err, "loaded")
+	}
+
+	err = StorageGetJSON(s, "number", &number)
+	if err != hestiaError.OK || number != 7 {
+		t.Fatalf("StorageGetJSON(...) = %d, %v, want 7", number, err)
+	}
+
+	keys, err = StorageKeys(s)
+	if err != hestiaError.OK || len(keys) != 2 {
+		t.Fatalf("StorageKeys(...) = %v, %v, want 2 keys", keys, err)
+	}
+
+	// a corrupted file is reported instead of being overwritten
+	ret = os.WriteFile(filepath.Join(directory, "corrupted.json"),
+		[]byte(`{"name":`),
+		storage_FILE_MODE,
+	)
+	if ret != nil {
+		t.Fatalf("WriteFile(...) = %v", ret)
+	}
+
+	s = &Storage{Path: filepath.Join(directory, "corrupted.json")}
+
+	err = StorageSet(s, "name", "lost")
+	if err != hestiaError.EBADMSG {
+		t.Fatalf("StorageSet(...) corrupted = %v, want EBADMSG", err)
+	}
+}
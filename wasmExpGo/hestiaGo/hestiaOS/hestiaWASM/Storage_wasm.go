@@ -0,0 +1,218 @@
+// Copyright 2022 "Holloway" Chew, Kean Ho <hollowaykeanho@gmail.com>
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+//go:build wasm
+// +build wasm
+
+package hestiaWASM
+
+import (
+	"hestiaGo/hestiaError"
+	"syscall/js"
+)
+
+type storageWatcher struct {
+	handler js.Func
+	queue   *storageQueue
+}
+
+func _storageClear(s *Storage) (err hestiaError.Error) {
+	var area js.Value
+
+	area, err = __storageArea(s)
+	if err != hestiaError.OK {
+		return err
+	}
+
+	return __storageCall(area, id_JS_STORAGE_CLEAR)
+}
+
+func _storageDelete(s *Storage, key string) (err hestiaError.Error) {
+	var area js.Value
+
+	area, err = __storageArea(s)
+	if err != hestiaError.OK {
+		return err
+	}
+
+	return __storageCall(area, id_JS_STORAGE_REMOVE_ITEM, key)
+}
+
+func _storageGet(s *Storage, key string) (string, hestiaError.Error) {
+	var area, value js.Value
+	var err hestiaError.Error
+
+	area, err = __storageArea(s)
+	if err != hestiaError.OK {
+		return "", err
+	}
+
+	value = area.Call(id_JS_STORAGE_GET_ITEM, key)
+	if value.Type() != js.TypeString {
+		return "", hestiaError.ENOENT
+	}
+
+	return value.String(), hestiaError.OK
+}
+
+func _storageKeys(s *Storage) ([]string, hestiaError.Error) {
+	var area js.Value
+	var list []string
+	var i, length int
+	var err hestiaError.Error
+
+	area, err = __storageArea(s)
+	if err != hestiaError.OK {
+		return nil, err
+	}
+
+	length = area.Get(id_JS_LENGTH).Int()
+	list = make([]string, 0, length)
+	for i = 0; i < length; i++ {
+		list = append(list, area.Call(id_JS_STORAGE_KEY, i).String())
+	}
+
+	return list, hestiaError.OK
+}
+
+func _storageSet(s *Storage, key string, value string) (err hestiaError.Error) {
+	var area js.Value
+
+	area, err = __storageArea(s)
+	if err != hestiaError.OK {
+		return err
+	}
+
+	return __storageCall(area, id_JS_STORAGE_SET_ITEM, key, value)
+}
+
+func _storageUnwatch(s *Storage) {
+	Global().value.Call(id_JS_REMOVE_EVENT_LISTENER,
+		id_JS_STORAGE_EVENT,
+		s.watcher.handler,
+	)
+
+	__release(FUNC_KIND_EVENT_LISTENER, &s.watcher.handler)
+}
+
+func _storageWatch(s *Storage) (*storageWatcher, hestiaError.Error) {
+	var watcher *storageWatcher
+	var area js.Value
+	var err hestiaError.Error
+
+	area, err = __storageArea(s)
+	if err != hestiaError.OK {
+		return nil, err
+	}
+
+	watcher = &storageWatcher{
+		queue: &storageQueue{fx: s.OnChange},
+	}
+	watcher.handler = __funcOf(FUNC_KIND_EVENT_LISTENER,
+		func(this js.Value, args []js.Value) any {
+			var event js.Value
+			var change *StorageChange
+
+			// the event is fired for both areas
+			event = args[0]
+			if !event.Get(id_JS_STORAGE_STORAGE_AREA).Equal(area) {
+				return nil
+			}
+
+			change = &StorageChange{
+				Key:      __stringOf(event.Get(id_JS_STORAGE_KEY)),
+				OldValue: __stringOf(event.Get(id_JS_STORAGE_OLD_VALUE)),
+				NewValue: __stringOf(event.Get(id_JS_STORAGE_NEW_VALUE)),
+				URL:      __stringOf(event.Get(id_JS_STORAGE_URL)),
+			}
+
+			// `clear()` is reported with a `null` key
+			change.IsCleared = event.Get(id_JS_STORAGE_KEY).IsNull()
+
+			__queueStorage(watcher.queue, change)
+
+			return nil
+		},
+	)
+
+	Global().value.Call(id_JS_ADD_EVENT_LISTENER,
+		id_JS_STORAGE_EVENT,
+		watcher.handler,
+	)
+
+	return watcher, hestiaError.OK
+}
+
+// NOTE: all functions below are sub-functions. Please use the global version
+// since it has proper guarding like `nil` object checking.
+
+func __storageArea(s *Storage) (area js.Value, err hestiaError.Error) {
+	defer func() {
+		// accessing a disabled storage throws SecurityError
+		if r := recover(); r != nil {
+			area = js.Undefined()
+			err = hestiaError.EACCES
+		}
+	}()
+
+	switch s.Area {
+	case STORAGE_SESSION:
+		area = Global().value.Get(id_JS_STORAGE_SESSION)
+	default:
+		area = Global().value.Get(id_JS_STORAGE_LOCAL)
+	}
+
+	if area.Type() != js.TypeObject {
+		return js.Undefined(), hestiaError.EACCES
+	}
+
+	return area, hestiaError.OK
+}
+
+func __storageCall(area js.Value, method string, args ...any) (err hestiaError.Error) {
+	defer func() {
+		var r any
+		var exception js.Error
+		var name string
+		var ok bool
+
+		r = recover()
+		if r == nil {
+			return
+		}
+
+		err = hestiaError.EACCES
+
+		exception, ok = r.(js.Error)
+		if !ok {
+			return
+		}
+
+		name = __stringOf(exception.Value.Get(id_JS_STORAGE_ERROR_NAME))
+		switch name {
+		case id_JS_STORAGE_ERROR_QUOTA, id_JS_STORAGE_ERROR_QUOTA_FIREFOX:
+			err = hestiaError.ENOSPC
+		}
+	}()
+
+	area.Call(method, args...)
+
+	return hestiaError.OK
+}
@@ -0,0 +1,264 @@
+// Copyright 2022 "Holloway" Chew, Kean Ho <hollowaykeanho@gmail.com>
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package hestiaWASM
+
+import (
+	"hestiaGo/hestiaError"
+	"sync"
+	"time"
+)
+
+// FrameLoop is the hestiaWASM scheduler aligned to the browser frames.
+//
+// It is implemented over Javascript `requestAnimationFrame` in wasm builds and
+// over a 60 frames per second `time.Ticker` in other builds.
+//
+// Function is executed in a separate goroutine once per frame. Frames are
+// skipped while the previous Function is still running so a slow Function
+// never piles up. The Javascript callback is released once the loop is
+// stopped via `FrameStop(...)`.
+type FrameLoop struct {
+	// Function is the function to execute for each frame.
+	//
+	// The `timestamp` is the frame time in milliseconds since the page was
+	// loaded (or since the process started in non-wasm builds).
+	Function func(timestamp float64)
+
+	// RunWhenHidden keeps the loop running while the page is hidden.
+	//
+	// Default (`false`) pauses the loop when the page is hidden and resumes
+	// it when visible again. It is not used in non-wasm builds.
+	RunWhenHidden bool
+
+	handle *frameHandle
+	mutex  sync.Mutex
+}
+
+// Timer is the hestiaWASM adapter for Javascript `setTimeout` and
+// `setInterval`.
+//
+// It is implemented over the Javascript timers in wasm builds and over
+// `time` in other builds.
+//
+// Function is executed in a separate goroutine. For a repeating Timer,
+// the ticks are skipped while the previous Function is still running. The
+// Javascript callback is released once a one-shot Timer fired or the Timer is
+// stopped via `TimerStop(...)`.
+type Timer struct {
+	// Function is the function to execute.
+	Function func()
+
+	// Delay is the waiting duration before executing Function (or between
+	// each execution when IsRepeat is `true`).
+	Delay time.Duration
+
+	// IsRepeat executes Function repeatedly like `setInterval`.
+	IsRepeat bool
+
+	handle *timerHandle
+	mutex  sync.Mutex
+}
+
+// FramePause pauses a running FrameLoop.
+//
+// It accepts the following parameters:
+//   1. `loop` - the FrameLoop.
+//
+// It shall returns:
+//   1. hestiaError.OK | `0` - operation successful.
+//   2. hestiaError.EOWNERDEAD - given `loop` is `nil`.
+//   3. hestiaError.ENOENT - given `loop` is not running.
+func FramePause(loop *FrameLoop) hestiaError.Error {
+	return __setFramePause(loop, true)
+}
+
+// FrameResume resumes a paused FrameLoop.
+//
+// It accepts the following parameters:
+//   1. `loop` - the FrameLoop.
+//
+// It shall returns:
+//   1. hestiaError.OK | `0` - operation successful.
+//   2. hestiaError.EOWNERDEAD - given `loop` is `nil`.
+//   3. hestiaError.ENOENT - given `loop` is not running.
+func FrameResume(loop *FrameLoop) hestiaError.Error {
+	return __setFramePause(loop, false)
+}
+
+// FrameStart starts a FrameLoop.
+//
+// It accepts the following parameters:
+//   1. `loop` - the FrameLoop.
+//
+// It shall returns:
+//   1. hestiaError.OK | `0` - operation successful.
+//   2. hestiaError.EOWNERDEAD - given `loop` is `nil`.
+//   3. hestiaError.ENOMEDIUM - given `loop` has no Function.
+//   4. hestiaError.EALREADY - given `loop` is already running.
+//   5. hestiaError.EOPNOTSUPP - the browser has no `requestAnimationFrame`.
+func FrameStart(loop *FrameLoop) (err hestiaError.Error) {
+	if loop == nil {
+		return hestiaError.EOWNERDEAD
+	}
+
+	if loop.Function == nil {
+		return hestiaError.ENOMEDIUM
+	}
+
+	loop.mutex.Lock()
+	defer loop.mutex.Unlock()
+
+	if loop.handle != nil {
+		return hestiaError.EALREADY
+	}
+
+	loop.handle, err = _frameStart(loop)
+
+	return err
+}
+
+// FrameStop stops a FrameLoop and releases its Javascript callbacks.
+//
+// It accepts the following parameters:
+//   1. `loop` - the FrameLoop.
+//
+// It shall returns:
+//   1. hestiaError.OK | `0` - operation successful.
+//   2. hestiaError.EOWNERDEAD - given `loop` is `nil`.
+//   3. hestiaError.ENOENT - given `loop` is not running.
+func FrameStop(loop *FrameLoop) hestiaError.Error {
+	if loop == nil {
+		return hestiaError.EOWNERDEAD
+	}
+
+	loop.mutex.Lock()
+	defer loop.mutex.Unlock()
+
+	if loop.handle == nil {
+		return hestiaError.ENOENT
+	}
+
+	_frameStop(loop.handle)
+	loop.handle = nil
+
+	return hestiaError.OK
+}
+
+// TimerStart starts a Timer.
+//
+// A fired one-shot Timer can be started again.
+//
+// It accepts the following parameters:
+//   1. `timer` - the Timer.
+//
+// It shall returns:
+//   1. hestiaError.OK | `0` - operation successful.
+//   2. hestiaError.EOWNERDEAD - given `timer` is `nil`.
+//   3. hestiaError.ENOMEDIUM - given `timer` has no Function.
+//   4. hestiaError.EINVAL - given `timer` has a negative Delay or a repeating
+//                           Timer has no Delay.
+//   5. hestiaError.EALREADY - given `timer` is already running.
+func TimerStart(timer *Timer) (err hestiaError.Error) {
+	if timer == nil {
+		return hestiaError.EOWNERDEAD
+	}
+
+	if timer.Function == nil {
+		return hestiaError.ENOMEDIUM
+	}
+
+	if timer.Delay < 0 || (timer.IsRepeat && timer.Delay == 0) {
+		return hestiaError.EINVAL
+	}
+
+	timer.mutex.Lock()
+	defer timer.mutex.Unlock()
+
+	if timer.handle != nil {
+		return hestiaError.EALREADY
+	}
+
+	timer.handle, err = _timerStart(timer)
+
+	return err
+}
+
+// TimerStop cancels a Timer and releases its Javascript callback.
+//
+// It accepts the following parameters:
+//   1. `timer` - the Timer.
+//
+// It shall returns:
+//   1. hestiaError.OK | `0` - operation successful.
+//   2. hestiaError.EOWNERDEAD - given `timer` is `nil`.
+//   3. hestiaError.ENOENT - given `timer` is not running (including a fired
+//                           one-shot Timer).
+func TimerStop(timer *Timer) hestiaError.Error {
+	if timer == nil {
+		return hestiaError.EOWNERDEAD
+	}
+
+	timer.mutex.Lock()
+	defer timer.mutex.Unlock()
+
+	if timer.handle == nil {
+		return hestiaError.ENOENT
+	}
+
+	_timerStop(timer.handle)
+	timer.handle = nil
+
+	return hestiaError.OK
+}
+
+// NOTE: all functions below are sub-functions. Please use the global version
+// since it has proper guarding like `nil` object checking.
+
+func __setFramePause(loop *FrameLoop, paused bool) hestiaError.Error {
+	if loop == nil {
+		return hestiaError.EOWNERDEAD
+	}
+
+	loop.mutex.Lock()
+	defer loop.mutex.Unlock()
+
+	if loop.handle == nil {
+		return hestiaError.ENOENT
+	}
+
+	_framePause(loop.handle, paused)
+
+	return hestiaError.OK
+}
+
+func __timerFired(timer *Timer, handle *timerHandle) bool {
+	timer.mutex.Lock()
+	defer timer.mutex.Unlock()
+
+	// stopped or restarted meanwhile
+	if timer.handle != handle {
+		return false
+	}
+
+	timer.handle = nil
+
+	return true
+}
@@ -0,0 +1,143 @@
+// Copyright 2022 "Holloway" Chew, Kean Ho <hollowaykeanho@gmail.com>
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+//go:build !wasm
+// +build !wasm
+
+package hestiaWASM
+
+import (
+	"hestiaGo/hestiaError"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	frame_INTERVAL = time.Second / 60
+)
+
+var timeOrigin = time.Now()
+
+type timerHandle struct {
+	timer *time.Timer
+	stop  chan struct{}
+}
+
+type frameHandle struct {
+	stop   chan struct{}
+	paused int32
+}
+
+func _framePause(handle *frameHandle, paused bool) {
+	if paused {
+		atomic.StoreInt32(&handle.paused, 1)
+		return
+	}
+
+	atomic.StoreInt32(&handle.paused, 0)
+}
+
+func _frameStart(loop *FrameLoop) (*frameHandle, hestiaError.Error) {
+	var handle *frameHandle
+	var fx func(float64)
+
+	fx = loop.Function
+	handle = &frameHandle{
+		stop: make(chan struct{}),
+	}
+
+	go func() {
+		var ticker *time.Ticker
+		var now time.Time
+
+		// ticks are dropped while fx is running
+		ticker = time.NewTicker(frame_INTERVAL)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-handle.stop:
+				return
+			case now = <-ticker.C:
+			}
+
+			if atomic.LoadInt32(&handle.paused) != 0 {
+				continue
+			}
+
+			fx(float64(now.Sub(timeOrigin)) / float64(time.Millisecond))
+		}
+	}()
+
+	return handle, hestiaError.OK
+}
+
+func _frameStop(handle *frameHandle) {
+	close(handle.stop)
+}
+
+func _timerStart(timer *Timer) (*timerHandle, hestiaError.Error) {
+	var handle *timerHandle
+	var fx func()
+
+	fx = timer.Function
+	handle = &timerHandle{}
+
+	if !timer.IsRepeat {
+		handle.timer = time.AfterFunc(timer.Delay, func() {
+			if __timerFired(timer, handle) {
+				fx()
+			}
+		})
+
+		return handle, hestiaError.OK
+	}
+
+	handle.stop = make(chan struct{})
+	go func(delay time.Duration) {
+		var ticker *time.Ticker
+
+		// ticks are dropped while fx is running
+		ticker = time.NewTicker(delay)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-handle.stop:
+				return
+			case <-ticker.C:
+			}
+
+			fx()
+		}
+	}(timer.Delay)
+
+	return handle, hestiaError.OK
+}
+
+func _timerStop(handle *timerHandle) {
+	if handle.timer != nil {
+		handle.timer.Stop()
+	}
+
+	if handle.stop != nil {
+		close(handle.stop)
+	}
+}
@@ -0,0 +1,274 @@
+// Copyright 2022 "Holloway" Chew, Kean Ho <hollowaykeanho@gmail.com>
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+//go:build !wasm
+// +build !wasm
+
+package hestiaWASM
+
+import (
+	"hestiaGo/hestiaError"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+const (
+	timer_TEST_SETTLE  = 100 * time.Millisecond
+	timer_TEST_TIMEOUT = 3 * time.Second
+)
+
+func TestTimerOneShot(t *testing.T) {
+	var timer *Timer
+	var fired chan struct{}
+	var i int
+	var err hestiaError.Error
+
+	fired = make(chan struct{}, 4)
+	timer = &Timer{
+		Delay: 10 * time.Millisecond,
+		Function: func() {
+			fired <- struct{}{}
+		},
+	}
+
+	// a fired one-shot Timer can be started again
+	for i = 0; i < 2; i++ {
+		err = TimerStart(timer)
+		if err != hestiaError.OK {
+			t.Fatalf("TimerStart(...) run %d = %v, want OK", i, err)
+		}
+
+		__waitTimerTest(t, fired, "one-shot Function")
+
+		err = TimerStop(timer)
+		if err != hestiaError.ENOENT {
+			t.Fatalf("TimerStop(...) after fired = %v, want ENOENT", err)
+		}
+	}
+
+	select {
+	case <-fired:
+		t.Fatal("one-shot Function fired more than once")
+	case <-time.After(timer_TEST_SETTLE):
+	}
+}
+
+func TestTimerRepeat(t *testing.T) {
+	var timer *Timer
+	var fired chan struct{}
+	var count int32
+	var i int
+	var err hestiaError.Error
+
+	fired = make(chan struct{}, 1)
+	timer = &Timer{
+		Delay:    5 * time.Millisecond,
+		IsRepeat: true,
+		Function: func() {
+			atomic.AddInt32(&count, 1)
+
+			select {
+			case fired <- struct{}{}:
+			default:
+			}
+		},
+	}
+
+	err = TimerStart(timer)
+	if err != hestiaError.OK {
+		t.Fatalf("TimerStart(...) = %v, want OK", err)
+	}
+
+	err = TimerStart(timer)
+	if err != hestiaError.EALREADY {
+		t.Fatalf("TimerStart(...) again = %v, want EALREADY", err)
+	}
+
+	for i = 0; i < 3; i++ {
+		__waitTimerTest(t, fired, "repeating Function")
+	}
+
+	err = TimerStop(timer)
+	if err != hestiaError.OK {
+		t.Fatalf("TimerStop(...) = %v, want OK", err)
+	}
+
+	__expectStillTimerTest(t, &count, "repeating Function after TimerStop")
+}
+
+func TestTimerStop(t *testing.T) {
+	var timer *Timer
+	var count int32
+	var err hestiaError.Error
+
+	timer = &Timer{
+		Delay: 50 * time.Millisecond,
+		Function: func() {
+			atomic.AddInt32(&count, 1)
+		},
+	}
+
+	err = TimerStop(timer)
+	if err != hestiaError.ENOENT {
+		t.Fatalf("TimerStop(...) before start = %v, want ENOENT", err)
+	}
+
+	err = TimerStart(timer)
+	if err != hestiaError.OK {
+		t.Fatalf("TimerStart(...) = %v, want OK", err)
+	}
+
+	err = TimerStop(timer)
+	if err != hestiaError.OK {
+		t.Fatalf("TimerStop(...) = %v, want OK", err)
+	}
+
+	time.Sleep(timer.Delay + timer_TEST_SETTLE)
+	if atomic.LoadInt32(&count) != 0 {
+		t.Fatal("stopped one-shot Function was executed")
+	}
+
+	err = TimerStart(&Timer{IsRepeat: true, Function: func() {}})
+	if err != hestiaError.EINVAL {
+		t.Fatalf("TimerStart(...) repeat without Delay = %v, want EINVAL", err)
+	}
+}
+
+func TestFrameLoop(t *testing.T) {
+	var loop *FrameLoop
+	var fired chan struct{}
+	var timestamps chan float64
+	var count int32
+	var last, timestamp float64
+	var i int
+	var err hestiaError.Error
+
+	fired = make(chan struct{}, 1)
+	loop = &FrameLoop{
+		Function: func(timestamp float64) {
+			atomic.AddInt32(&count, 1)
+
+			select {
+			case fired <- struct{}{}:
+			default:
+			}
+		},
+	}
+
+	err = FramePause(loop)
+	if err != hestiaError.ENOENT {
+		t.Fatalf("FramePause(...) before start = %v, want ENOENT", err)
+	}
+
+	err = FrameStart(loop)
+	if err != hestiaError.OK {
+		t.Fatalf("FrameStart(...) = %v, want OK", err)
+	}
+
+	for i = 0; i < 3; i++ {
+		__waitTimerTest(t, fired, "FrameLoop Function")
+	}
+
+	err = FramePause(loop)
+	if err != hestiaError.OK {
+		t.Fatalf("FramePause(...) = %v, want OK", err)
+	}
+
+	__expectStillTimerTest(t, &count, "paused FrameLoop Function")
+
+	// drop the frame signalled before pausing
+	select {
+	case <-fired:
+	default:
+	}
+
+	err = FrameResume(loop)
+	if err != hestiaError.OK {
+		t.Fatalf("FrameResume(...) = %v, want OK", err)
+	}
+
+	__waitTimerTest(t, fired, "resumed FrameLoop Function")
+
+	err = FrameStop(loop)
+	if err != hestiaError.OK {
+		t.Fatalf("FrameStop(...) = %v, want OK", err)
+	}
+
+	__expectStillTimerTest(t, &count, "FrameLoop Function after FrameStop")
+
+	err = FrameStop(loop)
+	if err != hestiaError.ENOENT {
+		t.Fatalf("FrameStop(...) again = %v, want ENOENT", err)
+	}
+
+	// the timestamps are increasing milliseconds
+	timestamps = make(chan float64, 2)
+	loop = &FrameLoop{
+		Function: func(now float64) {
+			select {
+			case timestamps <- now:
+			default:
+			}
+		},
+	}
+
+	_ = FrameStart(loop)
+	defer FrameStop(loop)
+
+	select {
+	case last = <-timestamps:
+	case <-time.After(timer_TEST_TIMEOUT):
+		t.Fatal("FrameLoop Function was not executed")
+	}
+
+	select {
+	case timestamp = <-timestamps:
+	case <-time.After(timer_TEST_TIMEOUT):
+		t.Fatal("FrameLoop Function was not executed")
+	}
+
+	if timestamp <= last {
+		t.Fatalf("timestamp = %v after %v, want increasing", timestamp, last)
+	}
+}
+
+// NOTE: all functions below are test helpers.
+
+func __expectStillTimerTest(t *testing.T, count *int32, name string) {
+	var before int32
+
+	// a Function running while stopping may still finish
+	time.Sleep(timer_TEST_SETTLE)
+	before = atomic.LoadInt32(count)
+
+	time.Sleep(timer_TEST_SETTLE)
+	if atomic.LoadInt32(count) != before {
+		t.Fatalf("%s was executed", name)
+	}
+}
+
+func __waitTimerTest(t *testing.T, fired chan struct{}, name string) {
+	select {
+	case <-fired:
+	case <-time.After(timer_TEST_TIMEOUT):
+		t.Fatalf("%s was not executed", name)
+	}
+}
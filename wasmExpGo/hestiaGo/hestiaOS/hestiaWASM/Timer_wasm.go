@@ -0,0 +1,225 @@
+// Copyright 2022 "Holloway" Chew, Kean Ho <hollowaykeanho@gmail.com>
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+//go:build wasm
+// +build wasm
+
+package hestiaWASM
+
+import (
+	"hestiaGo/hestiaError"
+	"sync/atomic"
+	"syscall/js"
+	"time"
+)
+
+type timerHandle struct {
+	function js.Func
+	id       js.Value
+	clear    string
+	busy     int32
+}
+
+type frameHandle struct {
+	frame      js.Func
+	visibility js.Func
+	document   js.Value
+	id         js.Value
+	busy       int32
+	requested  bool
+	paused     bool
+	hidden     bool
+	stopped    bool
+}
+
+func _framePause(handle *frameHandle, paused bool) {
+	handle.paused = paused
+	__frameSchedule(handle)
+}
+
+func _frameStart(loop *FrameLoop) (*frameHandle, hestiaError.Error) {
+	var handle *frameHandle
+	var fx func(float64)
+
+	if Global().value.Get(id_JS_TIMER_REQUEST_ANIMATION_FRAME).Type() !=
+		js.TypeFunction {
+		return nil, hestiaError.EOPNOTSUPP
+	}
+
+	fx = loop.Function
+	handle = &frameHandle{
+		document: *(Document().value),
+	}
+
+	handle.frame = __funcOf(FUNC_KIND_TIMER, func(this js.Value, args []js.Value) any {
+		var timestamp float64
+
+		timestamp = args[0].Float()
+
+		// request the next frame first to keep the cadence
+		loop.mutex.Lock()
+		handle.requested = false
+		if handle.stopped {
+			loop.mutex.Unlock()
+			return nil
+		}
+		__frameSchedule(handle)
+		loop.mutex.Unlock()
+
+		// skip the frame while the previous one is still running
+		if !atomic.CompareAndSwapInt32(&handle.busy, 0, 1) {
+			return nil
+		}
+
+		go func() {
+			defer atomic.StoreInt32(&handle.busy, 0)
+			fx(timestamp)
+		}()
+
+		return nil
+	})
+
+	if !loop.RunWhenHidden {
+		handle.hidden = __frameHidden(handle)
+		handle.visibility = __funcOf(FUNC_KIND_TIMER,
+			func(this js.Value, args []js.Value) any {
+				loop.mutex.Lock()
+				defer loop.mutex.Unlock()
+
+				if handle.stopped {
+					return nil
+				}
+
+				handle.hidden = __frameHidden(handle)
+				__frameSchedule(handle)
+
+				return nil
+			},
+		)
+
+		handle.document.Call(id_JS_ADD_EVENT_LISTENER,
+			id_JS_TIMER_VISIBILITY_CHANGE,
+			handle.visibility,
+		)
+	}
+
+	__frameSchedule(handle)
+
+	return handle, hestiaError.OK
+}
+
+func _frameStop(handle *frameHandle) {
+	handle.stopped = true
+	__frameSchedule(handle)
+
+	if handle.visibility.Truthy() {
+		handle.document.Call(id_JS_REMOVE_EVENT_LISTENER,
+			id_JS_TIMER_VISIBILITY_CHANGE,
+			handle.visibility,
+		)
+
+		__release(FUNC_KIND_TIMER, &handle.visibility)
+	}
+
+	__release(FUNC_KIND_TIMER, &handle.frame)
+}
+
+func _timerStart(timer *Timer) (*timerHandle, hestiaError.Error) {
+	var handle *timerHandle
+	var method string
+	var fx func()
+
+	fx = timer.Function
+	handle = &timerHandle{}
+
+	switch {
+	case timer.IsRepeat:
+		method = id_JS_TIMER_SET_INTERVAL
+		handle.clear = id_JS_TIMER_CLEAR_INTERVAL
+		handle.function = __funcOf(FUNC_KIND_TIMER,
+			func(this js.Value, args []js.Value) any {
+				// skip the tick while the previous one is still running
+				if !atomic.CompareAndSwapInt32(&handle.busy, 0, 1) {
+					return nil
+				}
+
+				go func() {
+					defer atomic.StoreInt32(&handle.busy, 0)
+					fx()
+				}()
+
+				return nil
+			},
+		)
+	default:
+		method = id_JS_TIMER_SET_TIMEOUT
+		handle.clear = id_JS_TIMER_CLEAR_TIMEOUT
+		handle.function = __funcOf(FUNC_KIND_TIMER,
+			func(this js.Value, args []js.Value) any {
+				if !__timerFired(timer, handle) {
+					return nil
+				}
+
+				// a one-shot timer is done once fired
+				__release(FUNC_KIND_TIMER, &handle.function)
+				go fx()
+
+				return nil
+			},
+		)
+	}
+
+	handle.id = Global().value.Call(method,
+		handle.function,
+		float64(timer.Delay)/float64(time.Millisecond),
+	)
+
+	return handle, hestiaError.OK
+}
+
+func _timerStop(handle *timerHandle) {
+	Global().value.Call(handle.clear, handle.id)
+	__release(FUNC_KIND_TIMER, &handle.function)
+}
+
+// NOTE: all functions below are sub-functions. Please use the global version
+// since it has proper guarding like `nil` object checking.
+
+func __frameHidden(handle *frameHandle) bool {
+	return handle.document.Get(id_JS_TIMER_VISIBILITY_STATE).String() ==
+		id_JS_TIMER_HIDDEN
+}
+
+func __frameSchedule(handle *frameHandle) {
+	var running bool
+
+	running = !handle.stopped && !handle.paused && !handle.hidden
+
+	switch {
+	case running && !handle.requested:
+		handle.id = Global().value.Call(id_JS_TIMER_REQUEST_ANIMATION_FRAME,
+			handle.frame,
+		)
+		handle.requested = true
+	case !running && handle.requested:
+		Global().value.Call(id_JS_TIMER_CANCEL_ANIMATION_FRAME, handle.id)
+		handle.requested = false
+	}
+}
@@ -0,0 +1,518 @@
+// Copyright 2022 "Holloway" Chew, Kean Ho <hollowaykeanho@gmail.com>
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package hestiaWASM
+
+import (
+	"hestiaGo/hestiaError"
+	"hestiaGo/hestiaOS"
+	"sync"
+	"time"
+)
+
+const (
+	websocket_BUFFER_SIZE_DEFAULT   = 64
+	websocket_CLOSE_ABNORMAL        = 1006
+	websocket_CLOSE_NORMAL          = 1000
+	websocket_RECONNECT_MAX_DEFAULT = 30 * time.Second
+	websocket_RECONNECT_MIN_DEFAULT = 1 * time.Second
+)
+
+// WebSocket is the hestiaWASM adapter for Javascript WebSocket.
+//
+// It is implemented over Javascript `WebSocket` in wasm builds and over a
+// pure-Go client in other builds so the same code can be tested against a
+// local server natively.
+//
+// Once opened via `WebSocketOpen(...)`, it keeps reconnecting with an
+// exponential backoff (from ReconnectMin doubling up to ReconnectMax) until
+// `WebSocketClose(...)` is called. Messages sent while disconnected are
+// buffered and flushed in order when reconnected.
+//
+// All the callbacks are executed sequentially in the WebSocket's own
+// goroutine so they **SHALL** return quickly. The incoming messages are
+// delivered to OnMessage when set. Otherwise, they are queued for
+// `WebSocketReceive(...)`.
+type WebSocket struct {
+	// OnOpen is called when connected (including each reconnection).
+	OnOpen func()
+
+	// OnClose is called when disconnected with the close code and reason.
+	OnClose func(code uint16, reason string)
+
+	// OnError is called when connecting failed.
+	OnError func(err hestiaError.Error)
+
+	// OnMessage is called for each incoming message in the received order.
+	//
+	// Default (`nil`) queues the messages for `WebSocketReceive(...)`.
+	OnMessage func(message *WebSocketMessage)
+
+	// Signal is the optional hestiaOS.Signal notified for each queued message.
+	//
+	// It allows a kernel waiting on Signal to pick up the message using
+	// `WebSocketReceive(...)`. It is not used when OnMessage is set.
+	//
+	// The signal is skipped when Signal's buffer is full so a slow kernel
+	// never stalls the connection. Hence, drain all the queued messages on
+	// each signal.
+	Signal *hestiaOS.Signal
+
+	// Protocols is the list of requested sub-protocols.
+	Protocols []string
+
+	// URL is the WebSocket server URL (e.g. `wss://example.com/live`).
+	URL string
+
+	// ReconnectMin is the initial reconnecting delay.
+	//
+	// Default (`0`) is 1 second.
+	ReconnectMin time.Duration
+
+	// ReconnectMax is the maximum reconnecting delay.
+	//
+	// Default (`0`) is 30 seconds.
+	ReconnectMax time.Duration
+
+	// BufferSize is the maximum buffered messages for both sending and
+	// receiving directions.
+	//
+	// Default (`0`) is 64.
+	BufferSize int
+
+	// SignalCode is the signal sent to Signal.
+	//
+	// Default (`0`) is `hestiaOS.SIGNAL_SIGIO`.
+	SignalCode uint16
+
+	// NoReconnect disables the automatic reconnection.
+	NoReconnect bool
+
+	session *webSocketSession
+	inbox   chan *WebSocketMessage
+	sending sync.Mutex
+	mutex   sync.Mutex
+}
+
+// webSocketSession is the state of one `WebSocketOpen(...)` until
+// `WebSocketClose(...)` so a closed WebSocket can be reopened immediately
+// while the old goroutine is still winding down.
+type webSocketSession struct {
+	conn  *webSocketConn
+	queue []*WebSocketMessage
+	inbox chan *WebSocketMessage
+	wake  chan struct{}
+}
+
+// WebSocketMessage is the WebSocket message.
+type WebSocketMessage struct {
+	// Data is the message payload.
+	Data []byte
+
+	// IsBinary states the message is a binary frame instead of a text one.
+	IsBinary bool
+}
+
+// WebSocketClose closes a WebSocket permanently.
+//
+// No more reconnection happens afterwards. Buffered outgoing messages are
+// discarded. A closed WebSocket can be opened again via `WebSocketOpen(...)`.
+//
+// It accepts the following parameters:
+//   1. `ws` - the WebSocket.
+//   2. `code` - the close code. `0` is `1000` (normal closure).
+//   3. `reason` - the close reason.
+//
+// It shall returns:
+//   1. hestiaError.OK | `0` - operation successful.
+//   2. hestiaError.EOWNERDEAD - given `ws` is `nil`.
+//   3. hestiaError.ENOTCONN - given `ws` is not opened.
+func WebSocketClose(ws *WebSocket, code uint16, reason string) hestiaError.Error {
+	var session *webSocketSession
+	var conn *webSocketConn
+
+	if ws == nil {
+		return hestiaError.EOWNERDEAD
+	}
+
+	if code == 0 {
+		code = websocket_CLOSE_NORMAL
+	}
+
+	ws.mutex.Lock()
+	session = ws.session
+	if session == nil {
+		ws.mutex.Unlock()
+		return hestiaError.ENOTCONN
+	}
+
+	ws.session = nil
+	session.queue = nil
+	conn = session.conn
+	close(session.wake)
+	ws.mutex.Unlock()
+
+	if conn != nil {
+		_closeWebSocket(conn, code, reason)
+	}
+
+	return hestiaError.OK
+}
+
+// WebSocketOpen starts connecting a WebSocket.
+//
+// It returns immediately while the connection is established in the
+// background. Use OnOpen and OnError to track the connection.
+//
+// It accepts the following parameters:
+//   1. `ws` - the WebSocket.
+//
+// It shall returns:
+//   1. hestiaError.OK | `0` - operation successful.
+//   2. hestiaError.EOWNERDEAD - given `ws` is `nil`.
+//   3. hestiaError.EDESTADDRREQ - given `ws` has no URL.
+//   4. hestiaError.EALREADY - given `ws` is already opened.
+func WebSocketOpen(ws *WebSocket) hestiaError.Error {
+	var session *webSocketSession
+	var size int
+
+	if ws == nil {
+		return hestiaError.EOWNERDEAD
+	}
+
+	if ws.URL == "" {
+		return hestiaError.EDESTADDRREQ
+	}
+
+	ws.mutex.Lock()
+	defer ws.mutex.Unlock()
+
+	if ws.session != nil {
+		return hestiaError.EALREADY
+	}
+
+	size = ws.BufferSize
+	if size <= 0 {
+		size = websocket_BUFFER_SIZE_DEFAULT
+	}
+
+	session = &webSocketSession{
+		inbox: make(chan *WebSocketMessage, size),
+		wake:  make(chan struct{}),
+	}
+	ws.session = session
+	ws.inbox = session.inbox
+
+	go __runWebSocket(ws, session)
+
+	return hestiaError.OK
+}
+
+// WebSocketReceive waits for the next queued incoming message.
+//
+// It is only useful when OnMessage is `nil`. Like `Await(...)`, it **SHALL
+// NOT** be called inside a Javascript callback.
+//
+// It accepts the following parameters:
+//   1. `ws` - the WebSocket.
+//   2. `timeout` - the maximum waiting duration. Default (`0`) waits forever.
+//
+// It shall returns:
+//   1. WebSocketMessage, hestiaError.OK | `0` - operation successful.
+//   2. `nil`, hestiaError.EOWNERDEAD - given `ws` is `nil`.
+//   3. `nil`, hestiaError.ENOTCONN - given `ws` is closed and no more queued
+//                                    messages.
+//   4. `nil`, hestiaError.ETIMEDOUT - no message arrived in time.
+func WebSocketReceive(ws *WebSocket, timeout time.Duration) (*WebSocketMessage, hestiaError.Error) {
+	var inbox chan *WebSocketMessage
+	var message *WebSocketMessage
+	var timer <-chan time.Time
+	var ok bool
+
+	if ws == nil {
+		return nil, hestiaError.EOWNERDEAD
+	}
+
+	ws.mutex.Lock()
+	inbox = ws.inbox
+	ws.mutex.Unlock()
+
+	if inbox == nil {
+		return nil, hestiaError.ENOTCONN
+	}
+
+	if timeout > 0 {
+		timer = time.After(timeout)
+	}
+
+	select {
+	case message, ok = <-inbox:
+		if !ok {
+			return nil, hestiaError.ENOTCONN
+		}
+
+		return message, hestiaError.OK
+	case <-timer:
+		return nil, hestiaError.ETIMEDOUT
+	}
+}
+
+// WebSocketSend sends a message through a WebSocket.
+//
+// When the WebSocket is reconnecting, the message is buffered and sent in
+// order once connected.
+//
+// It accepts the following parameters:
+//   1. `ws` - the WebSocket.
+//   2. `message` - the message to send.
+//
+// It shall returns:
+//   1. hestiaError.OK | `0` - operation successful (sent or buffered).
+//   2. hestiaError.EOWNERDEAD - given `ws` is `nil`.
+//   3. hestiaError.ENOMEDIUM - given `message` is `nil`.
+//   4. hestiaError.ENOTCONN - given `ws` is not opened or was closed.
+//   5. hestiaError.ENOBUFS - the sending buffer is full.
+//   6. hestiaError.EINVAL - given `message` cannot be sent.
+//   7. hestiaError.EIO - the message frame cannot be prepared.
+//
+// A message failing due to the connection loss is buffered for the
+// reconnection instead of failing.
+func WebSocketSend(ws *WebSocket, message *WebSocketMessage) hestiaError.Error {
+	var session *webSocketSession
+	var conn *webSocketConn
+	var size int
+	var err hestiaError.Error
+
+	if ws == nil {
+		return hestiaError.EOWNERDEAD
+	}
+
+	if message == nil {
+		return hestiaError.ENOMEDIUM
+	}
+
+	ws.sending.Lock()
+	defer ws.sending.Unlock()
+
+	ws.mutex.Lock()
+	session = ws.session
+	if session == nil {
+		ws.mutex.Unlock()
+		return hestiaError.ENOTCONN
+	}
+
+	conn = session.conn
+	if conn == nil {
+		size = ws.BufferSize
+		if size <= 0 {
+			size = websocket_BUFFER_SIZE_DEFAULT
+		}
+
+		if len(session.queue) >= size {
+			ws.mutex.Unlock()
+			return hestiaError.ENOBUFS
+		}
+
+		session.queue = append(session.queue, message)
+		ws.mutex.Unlock()
+
+		return hestiaError.OK
+	}
+	ws.mutex.Unlock()
+
+	err = _sendWebSocket(conn, message)
+	if err != hestiaError.ENOTCONN {
+		return err
+	}
+
+	// connection is dying; keep the message for the reconnection
+	ws.mutex.Lock()
+	if ws.session == session {
+		session.queue = append(session.queue, message)
+	}
+	ws.mutex.Unlock()
+
+	return hestiaError.OK
+}
+
+// NOTE: all functions below are sub-functions. Please use the global version
+// since it has proper guarding like `nil` object checking.
+
+func __runWebSocket(ws *WebSocket, session *webSocketSession) {
+	var conn *webSocketConn
+	var message *WebSocketMessage
+	var delay, limit time.Duration
+	var reason string
+	var code uint16
+	var err hestiaError.Error
+
+	delay = ws.ReconnectMin
+	if delay <= 0 {
+		delay = websocket_RECONNECT_MIN_DEFAULT
+	}
+
+	limit = ws.ReconnectMax
+	if limit <= 0 {
+		limit = websocket_RECONNECT_MAX_DEFAULT
+	}
+
+	for {
+		conn, err = _dialWebSocket(ws)
+		if err != hestiaError.OK {
+			if ws.OnError != nil {
+				ws.OnError(err)
+			}
+
+			goto reconnect
+		}
+
+		// connected
+		delay = ws.ReconnectMin
+		if delay <= 0 {
+			delay = websocket_RECONNECT_MIN_DEFAULT
+		}
+
+		if !__openWebSocket(ws, session, conn) {
+			_closeWebSocket(conn, websocket_CLOSE_NORMAL, "")
+
+			// drain until closed so the transport releases its resources
+			for {
+				message, _, _ = _receiveWebSocket(conn)
+				if message == nil {
+					break
+				}
+			}
+
+			goto end
+		}
+
+		if ws.OnOpen != nil {
+			ws.OnOpen()
+		}
+
+		for {
+			message, code, reason = _receiveWebSocket(conn)
+			if message == nil {
+				break
+			}
+
+			__deliverWebSocket(ws, session, message)
+		}
+
+		ws.mutex.Lock()
+		session.conn = nil
+		ws.mutex.Unlock()
+
+		if ws.OnClose != nil {
+			ws.OnClose(code, reason)
+		}
+
+	reconnect:
+		if ws.NoReconnect {
+			goto end
+		}
+
+		select {
+		case <-session.wake:
+			goto end
+		case <-time.After(delay):
+		}
+
+		delay *= 2
+		if delay > limit {
+			delay = limit
+		}
+	}
+
+end:
+	ws.mutex.Lock()
+	if ws.session == session {
+		ws.session = nil
+	}
+	session.conn = nil
+	session.queue = nil
+	close(session.inbox)
+	ws.mutex.Unlock()
+}
+
+func __deliverWebSocket(ws *WebSocket,
+	session *webSocketSession,
+	message *WebSocketMessage) {
+	var code uint16
+
+	if ws.OnMessage != nil {
+		ws.OnMessage(message)
+		return
+	}
+
+	// drop the message if closed while the inbox is full
+	select {
+	case session.inbox <- message:
+	case <-session.wake:
+		return
+	}
+
+	if ws.Signal != nil {
+		code = ws.SignalCode
+		if code == 0 {
+			code = hestiaOS.SIGNAL_SIGIO
+		}
+
+		// the message is queued already; a pending signal covers it
+		_ = hestiaOS.SignalTrySend(ws.Signal, code)
+	}
+}
+
+func __openWebSocket(ws *WebSocket,
+	session *webSocketSession,
+	conn *webSocketConn) bool {
+	var queue []*WebSocketMessage
+	var i int
+
+	// block new sending until the buffered ones are flushed in order
+	ws.sending.Lock()
+	defer ws.sending.Unlock()
+
+	ws.mutex.Lock()
+	if ws.session != session {
+		ws.mutex.Unlock()
+		return false
+	}
+
+	session.conn = conn
+	queue = session.queue
+	session.queue = nil
+	ws.mutex.Unlock()
+
+	for i = range queue {
+		// keep the unsent ones for the next reconnection
+		if _sendWebSocket(conn, queue[i]) == hestiaError.ENOTCONN {
+			ws.mutex.Lock()
+			if ws.session == session {
+				session.queue = append(queue[i:], session.queue...)
+			}
+			ws.mutex.Unlock()
+
+			break
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,405 @@
+// Copyright 2022 "Holloway" Chew, Kean Ho <hollowaykeanho@gmail.com>
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+//go:build !wasm
+// +build !wasm
+
+package hestiaWASM
+
+import (
+	"bufio"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/tls"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"hestiaGo/hestiaError"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	websocket_DIAL_TIMEOUT = 30 * time.Second
+	websocket_GUID         = "258EAFA5-E914-47DA-95CA-C5AB0DC85B11"
+	websocket_MESSAGE_MAX  = 32 << 20
+)
+
+const (
+	websocket_OP_CONTINUE = 0x0
+	websocket_OP_TEXT     = 0x1
+	websocket_OP_BINARY   = 0x2
+	websocket_OP_CLOSE    = 0x8
+	websocket_OP_PING     = 0x9
+	websocket_OP_PONG     = 0xA
+)
+
+const (
+	websocket_CLOSE_NO_STATUS = 1005
+	websocket_CLOSE_TOO_BIG   = 1009
+)
+
+// errWebSocketTooBig marks an incoming frame exceeding websocket_MESSAGE_MAX.
+var errWebSocketTooBig = errors.New("websocket message too big")
+
+type webSocketConn struct {
+	conn    net.Conn
+	reader  *bufio.Reader
+	writing sync.Mutex
+	closing bool
+}
+
+func _closeWebSocket(conn *webSocketConn, code uint16, reason string) {
+	var payload []byte
+
+	payload = make([]byte, 2, 2+len(reason))
+	binary.BigEndian.PutUint16(payload, code)
+	payload = append(payload, reason...)
+
+	conn.writing.Lock()
+	conn.closing = true
+	conn.writing.Unlock()
+
+	_ = __writeWebSocket(conn, websocket_OP_CLOSE, payload)
+
+	// give the server a moment to echo the closing handshake
+	_ = conn.conn.SetReadDeadline(time.Now().Add(time.Second))
+}
+
+func _dialWebSocket(ws *WebSocket) (conn *webSocketConn, err hestiaError.Error) {
+	var target *url.URL
+	var socket net.Conn
+	var dialer *net.Dialer
+	var address string
+	var ret error
+
+	target, ret = url.Parse(ws.URL)
+	if ret != nil || target.Host == "" {
+		return nil, hestiaError.EINVAL
+	}
+
+	address = target.Host
+	dialer = &net.Dialer{Timeout: websocket_DIAL_TIMEOUT}
+
+	switch target.Scheme {
+	case "ws":
+		if target.Port() == "" {
+			address = net.JoinHostPort(target.Hostname(), "80")
+		}
+
+		socket, ret = dialer.Dial("tcp", address)
+	case "wss":
+		if target.Port() == "" {
+			address = net.JoinHostPort(target.Hostname(), "443")
+		}
+
+		socket, ret = tls.DialWithDialer(dialer, "tcp", address, &tls.Config{
+			ServerName: target.Hostname(),
+		})
+	default:
+		return nil, hestiaError.EINVAL
+	}
+
+	if ret != nil {
+		return nil, hestiaError.ECONNREFUSED
+	}
+
+	conn = &webSocketConn{
+		conn:   socket,
+		reader: bufio.NewReader(socket),
+	}
+
+	err = __handshakeWebSocket(conn, target, ws.Protocols)
+	if err != hestiaError.OK {
+		_ = socket.Close()
+		return nil, err
+	}
+
+	return conn, hestiaError.OK
+}
+
+func _receiveWebSocket(conn *webSocketConn) (*WebSocketMessage, uint16, string) {
+	var message *WebSocketMessage
+	var payload []byte
+	var opcode byte
+	var fin bool
+	var ret error
+
+	for {
+		fin, opcode, payload, ret = __readWebSocket(conn)
+		if ret != nil {
+			_ = conn.conn.Close()
+
+			if ret == errWebSocketTooBig {
+				return nil, websocket_CLOSE_TOO_BIG, ""
+			}
+
+			return nil, websocket_CLOSE_ABNORMAL, ""
+		}
+
+		switch opcode {
+		case websocket_OP_PING:
+			_ = __writeWebSocket(conn, websocket_OP_PONG, payload)
+			continue
+		case websocket_OP_PONG:
+			continue
+		case websocket_OP_CLOSE:
+			return __closedWebSocket(conn, payload)
+		case websocket_OP_TEXT, websocket_OP_BINARY:
+			message = &WebSocketMessage{
+				Data:     payload,
+				IsBinary: opcode == websocket_OP_BINARY,
+			}
+		case websocket_OP_CONTINUE:
+			if message == nil {
+				_ = conn.conn.Close()
+				return nil, websocket_CLOSE_ABNORMAL, ""
+			}
+
+			if len(message.Data)+len(payload) > websocket_MESSAGE_MAX {
+				_ = conn.conn.Close()
+				return nil, websocket_CLOSE_TOO_BIG, ""
+			}
+
+			message.Data = append(message.Data, payload...)
+		default:
+			_ = conn.conn.Close()
+			return nil, websocket_CLOSE_ABNORMAL, ""
+		}
+
+		if fin {
+			return message, 0, ""
+		}
+	}
+}
+
+func _sendWebSocket(conn *webSocketConn, message *WebSocketMessage) hestiaError.Error {
+	var opcode byte
+
+	opcode = websocket_OP_TEXT
+	if message.IsBinary {
+		opcode = websocket_OP_BINARY
+	}
+
+	return __writeWebSocket(conn, opcode, message.Data)
+}
+
+// NOTE: all functions below are sub-functions. Please use the global version
+// since it has proper guarding like `nil` object checking.
+
+func __closedWebSocket(conn *webSocketConn, payload []byte) (*WebSocketMessage, uint16, string) {
+	var code uint16
+	var reason string
+	var closing bool
+
+	code = websocket_CLOSE_NO_STATUS
+	if len(payload) >= 2 {
+		code = binary.BigEndian.Uint16(payload)
+		reason = string(payload[2:])
+	}
+
+	conn.writing.Lock()
+	closing = conn.closing
+	conn.writing.Unlock()
+
+	// echo the closing handshake initiated by the server
+	if !closing {
+		_ = __writeWebSocket(conn, websocket_OP_CLOSE, payload)
+	}
+
+	_ = conn.conn.Close()
+
+	return nil, code, reason
+}
+
+func __handshakeWebSocket(conn *webSocketConn,
+	target *url.URL,
+	protocols []string) hestiaError.Error {
+	var request *http.Request
+	var response *http.Response
+	var nonce [16]byte
+	var accept [sha1.Size]byte
+	var key string
+	var ret error
+
+	_, ret = rand.Read(nonce[:])
+	if ret != nil {
+		return hestiaError.EIO
+	}
+	key = base64.StdEncoding.EncodeToString(nonce[:])
+
+	request = &http.Request{
+		Method:     http.MethodGet,
+		URL:        &url.URL{Path: target.Path, RawQuery: target.RawQuery},
+		Proto:      "HTTP/1.1",
+		ProtoMajor: 1,
+		ProtoMinor: 1,
+		Header:     http.Header{},
+		Host:       target.Host,
+	}
+
+	if request.URL.Path == "" {
+		request.URL.Path = "/"
+	}
+
+	request.Header.Set("Upgrade", "websocket")
+	request.Header.Set("Connection", "Upgrade")
+	request.Header.Set("Sec-WebSocket-Key", key)
+	request.Header.Set("Sec-WebSocket-Version", "13")
+	if len(protocols) > 0 {
+		request.Header.Set("Sec-WebSocket-Protocol",
+			strings.Join(protocols, ", "),
+		)
+	}
+
+	_ = conn.conn.SetDeadline(time.Now().Add(websocket_DIAL_TIMEOUT))
+	defer func() {
+		_ = conn.conn.SetDeadline(time.Time{})
+	}()
+
+	ret = request.Write(conn.conn)
+	if ret != nil {
+		return hestiaError.ECONNREFUSED
+	}
+
+	response, ret = http.ReadResponse(conn.reader, request)
+	if ret != nil {
+		return hestiaError.EPROTO
+	}
+	_ = response.Body.Close()
+
+	accept = sha1.Sum([]byte(key + websocket_GUID))
+
+	if response.StatusCode != http.StatusSwitchingProtocols ||
+		!strings.EqualFold(response.Header.Get("Upgrade"), "websocket") ||
+		response.Header.Get("Sec-WebSocket-Accept") !=
+			base64.StdEncoding.EncodeToString(accept[:]) {
+		return hestiaError.EPROTO
+	}
+
+	return hestiaError.OK
+}
+
+func __readWebSocket(conn *webSocketConn) (fin bool,
+	opcode byte,
+	payload []byte,
+	err error) {
+	var header [8]byte
+	var mask [4]byte
+	var length uint64
+	var masked bool
+	var i int
+
+	_, err = io.ReadFull(conn.reader, header[:2])
+	if err != nil {
+		return false, 0, nil, err
+	}
+
+	fin = header[0]&0x80 != 0
+	opcode = header[0] & 0x0F
+	masked = header[1]&0x80 != 0
+	length = uint64(header[1] & 0x7F)
+
+	switch length {
+	case 126:
+		_, err = io.ReadFull(conn.reader, header[:2])
+		length = uint64(binary.BigEndian.Uint16(header[:2]))
+	case 127:
+		_, err = io.ReadFull(conn.reader, header[:8])
+		length = binary.BigEndian.Uint64(header[:8])
+	}
+
+	if err != nil {
+		return false, 0, nil, err
+	}
+
+	if length > websocket_MESSAGE_MAX {
+		return false, 0, nil, errWebSocketTooBig
+	}
+
+	if masked {
+		_, err = io.ReadFull(conn.reader, mask[:])
+		if err != nil {
+			return false, 0, nil, err
+		}
+	}
+
+	payload = make([]byte, length)
+	_, err = io.ReadFull(conn.reader, payload)
+	if err != nil {
+		return false, 0, nil, err
+	}
+
+	if masked {
+		for i = range payload {
+			payload[i] ^= mask[i%4]
+		}
+	}
+
+	return fin, opcode, payload, nil
+}
+
+func __writeWebSocket(conn *webSocketConn, opcode byte, payload []byte) hestiaError.Error {
+	var frame []byte
+	var mask [4]byte
+	var length, i int
+	var ret error
+
+	length = len(payload)
+	frame = make([]byte, 0, 14+length)
+	frame = append(frame, 0x80|opcode)
+
+	// client frames are always masked
+	switch {
+	case length < 126:
+		frame = append(frame, 0x80|byte(length))
+	case length <= 0xFFFF:
+		frame = append(frame, 0x80|126, 0, 0)
+		binary.BigEndian.PutUint16(frame[2:], uint16(length))
+	default:
+		frame = append(frame, 0x80|127, 0, 0, 0, 0, 0, 0, 0, 0)
+		binary.BigEndian.PutUint64(frame[2:], uint64(length))
+	}
+
+	_, ret = rand.Read(mask[:])
+	if ret != nil {
+		return hestiaError.EIO
+	}
+	frame = append(frame, mask[:]...)
+
+	for i = 0; i < length; i++ {
+		frame = append(frame, payload[i]^mask[i%4])
+	}
+
+	conn.writing.Lock()
+	defer conn.writing.Unlock()
+
+	_, ret = conn.conn.Write(frame)
+	if ret != nil {
+		return hestiaError.ENOTCONN
+	}
+
+	return hestiaError.OK
+}
@@ -0,0 +1,344 @@
+// Copyright 2022 "Holloway" Chew, Kean Ho <hollowaykeanho@gmail.com>
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+//go:build !wasm
+// +build !wasm
+
+package hestiaWASM
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha1"
+	"encoding/base64"
+	"encoding/binary"
+	"hestiaGo/hestiaError"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+const (
+	websocket_TEST_TIMEOUT = 3 * time.Second
+)
+
+func TestWebSocketRoundTrip(t *testing.T) {
+	var server *httptest.Server
+	var ws *WebSocket
+	var message *WebSocketMessage
+	var data []byte
+	var err hestiaError.Error
+
+	server = __newWebSocketTestServer(func(index int32, conn net.Conn, reader *bufio.Reader) {
+		__echoWebSocketTest(conn, reader)
+	})
+	defer server.Close()
+
+	ws = &WebSocket{URL: __webSocketTestURL(server)}
+
+	err = WebSocketSend(ws, &WebSocketMessage{Data: []byte("early")})
+	if err != hestiaError.ENOTCONN {
+		t.Fatalf("WebSocketSend(...) before open = %v, want ENOTCONN", err)
+	}
+
+	err = WebSocketOpen(ws)
+	if err != hestiaError.OK {
+		t.Fatalf("WebSocketOpen(...) = %v, want OK", err)
+	}
+	defer WebSocketClose(ws, 0, "")
+
+	// longer than 125 bytes for the extended payload length
+	data = bytes.Repeat([]byte{0x00, 0xFF, 0x7F}, 100)
+
+	_ = WebSocketSend(ws, &WebSocketMessage{Data: []byte("hello")})
+	_ = WebSocketSend(ws, &WebSocketMessage{Data: data, IsBinary: true})
+
+	message, err = WebSocketReceive(ws, websocket_TEST_TIMEOUT)
+	if err != hestiaError.OK || message.IsBinary ||
+		string(message.Data) != "hello" {
+		t.Fatalf("WebSocketReceive(...) = %v, %v, want text %q",
+			message, err, "hello")
+	}
+
+	message, err = WebSocketReceive(ws, websocket_TEST_TIMEOUT)
+	if err != hestiaError.OK || !message.IsBinary ||
+		!bytes.Equal(message.Data, data) {
+		t.Fatalf("WebSocketReceive(...) = %v, %v, want binary echo",
+			message, err)
+	}
+
+	err = WebSocketClose(ws, 0, "bye")
+	if err != hestiaError.OK {
+		t.Fatalf("WebSocketClose(...) = %v, want OK", err)
+	}
+
+	_, err = WebSocketReceive(ws, websocket_TEST_TIMEOUT)
+	if err != hestiaError.ENOTCONN {
+		t.Fatalf("WebSocketReceive(...) after close = %v, want ENOTCONN", err)
+	}
+}
+
+func TestWebSocketReconnect(t *testing.T) {
+	var server *httptest.Server
+	var ws *WebSocket
+	var opened chan struct{}
+	var closed chan uint16
+	var message *WebSocketMessage
+	var code uint16
+	var err hestiaError.Error
+
+	server = __newWebSocketTestServer(func(index int32, conn net.Conn, reader *bufio.Reader) {
+		// drop the first connection without the closing handshake
+		if index == 1 {
+			return
+		}
+
+		__echoWebSocketTest(conn, reader)
+	})
+	defer server.Close()
+
+	opened = make(chan struct{}, 4)
+	closed = make(chan uint16, 4)
+	ws = &WebSocket{
+		URL:          __webSocketTestURL(server),
+		ReconnectMin: 10 * time.Millisecond,
+		OnOpen: func() {
+			opened <- struct{}{}
+		},
+		OnClose: func(code uint16, reason string) {
+			closed <- code
+		},
+	}
+
+	err = WebSocketOpen(ws)
+	if err != hestiaError.OK {
+		t.Fatalf("WebSocketOpen(...) = %v, want OK", err)
+	}
+	defer WebSocketClose(ws, 0, "")
+
+	__waitWebSocketTest(t, opened, "first OnOpen")
+
+	select {
+	case code = <-closed:
+		if code != websocket_CLOSE_ABNORMAL {
+			t.Fatalf("OnClose code = %d, want %d",
+				code, websocket_CLOSE_ABNORMAL)
+		}
+	case <-time.After(websocket_TEST_TIMEOUT):
+		t.Fatal("OnClose was not called for the dropped connection")
+	}
+
+	__waitWebSocketTest(t, opened, "reconnected OnOpen")
+
+	_ = WebSocketSend(ws, &WebSocketMessage{Data: []byte("again")})
+
+	message, err = WebSocketReceive(ws, websocket_TEST_TIMEOUT)
+	if err != hestiaError.OK || string(message.Data) != "again" {
+		t.Fatalf("WebSocketReceive(...) = %v, %v, want %q",
+			message, err, "again")
+	}
+}
+
+func TestWebSocketSendBuffering(t *testing.T) {
+	var server *httptest.Server
+	var ws *WebSocket
+	var closed chan uint16
+	var message *WebSocketMessage
+	var data string
+	var err hestiaError.Error
+
+	server = __newWebSocketTestServer(func(index int32, conn net.Conn, reader *bufio.Reader) {
+		if index == 1 {
+			return
+		}
+
+		__echoWebSocketTest(conn, reader)
+	})
+	defer server.Close()
+
+	closed = make(chan uint16, 4)
+	ws = &WebSocket{
+		URL:          __webSocketTestURL(server),
+		BufferSize:   2,
+		ReconnectMin: 200 * time.Millisecond,
+		OnClose: func(code uint16, reason string) {
+			closed <- code
+		},
+	}
+
+	err = WebSocketOpen(ws)
+	if err != hestiaError.OK {
+		t.Fatalf("WebSocketOpen(...) = %v, want OK", err)
+	}
+	defer WebSocketClose(ws, 0, "")
+
+	select {
+	case <-closed:
+	case <-time.After(websocket_TEST_TIMEOUT):
+		t.Fatal("OnClose was not called for the dropped connection")
+	}
+
+	// buffered while waiting for the reconnection
+	for _, data = range []string{"first", "second"} {
+		err = WebSocketSend(ws, &WebSocketMessage{Data: []byte(data)})
+		if err != hestiaError.OK {
+			t.Fatalf("WebSocketSend(%q) = %v, want OK", data, err)
+		}
+	}
+
+	err = WebSocketSend(ws, &WebSocketMessage{Data: []byte("third")})
+	if err != hestiaError.ENOBUFS {
+		t.Fatalf("WebSocketSend(...) over BufferSize = %v, want ENOBUFS", err)
+	}
+
+	for _, data = range []string{"first", "second"} {
+		message, err = WebSocketReceive(ws, websocket_TEST_TIMEOUT)
+		if err != hestiaError.OK || string(message.Data) != data {
+			t.Fatalf("WebSocketReceive(...) = %v, %v, want %q",
+				message, err, data)
+		}
+	}
+}
+
+// NOTE: all functions below are test helpers running the server side.
+
+func __echoWebSocketTest(conn net.Conn, reader *bufio.Reader) {
+	var header [8]byte
+	var mask [4]byte
+	var payload []byte
+	var length uint64
+	var opcode byte
+	var i int
+	var err error
+
+	for {
+		_, err = io.ReadFull(reader, header[:2])
+		if err != nil {
+			return
+		}
+
+		opcode = header[0] & 0x0F
+		length = uint64(header[1] & 0x7F)
+
+		switch length {
+		case 126:
+			_, err = io.ReadFull(reader, header[:2])
+			length = uint64(binary.BigEndian.Uint16(header[:2]))
+		case 127:
+			_, err = io.ReadFull(reader, header[:8])
+			length = binary.BigEndian.Uint64(header[:8])
+		}
+
+		if err != nil {
+			return
+		}
+
+		// client frames are always masked
+		_, err = io.ReadFull(reader, mask[:])
+		if err != nil {
+			return
+		}
+
+		payload = make([]byte, length)
+		_, err = io.ReadFull(reader, payload)
+		if err != nil {
+			return
+		}
+
+		for i = range payload {
+			payload[i] ^= mask[i%4]
+		}
+
+		if opcode == websocket_OP_CLOSE {
+			__writeWebSocketTest(conn, 0x80|websocket_OP_CLOSE, payload)
+			return
+		}
+
+		// echo in 2 fragments to exercise the continuation frames
+		__writeWebSocketTest(conn, opcode, payload[:1])
+		__writeWebSocketTest(conn, 0x80|websocket_OP_CONTINUE, payload[1:])
+	}
+}
+
+func __newWebSocketTestServer(serve func(index int32, conn net.Conn, reader *bufio.Reader)) *httptest.Server {
+	var count int32
+
+	return httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			var accept [sha1.Size]byte
+			var buffer *bufio.ReadWriter
+			var conn net.Conn
+			var err error
+
+			accept = sha1.Sum([]byte(
+				r.Header.Get("Sec-WebSocket-Key") + websocket_GUID,
+			))
+
+			conn, buffer, err = w.(http.Hijacker).Hijack()
+			if err != nil {
+				return
+			}
+			defer conn.Close()
+
+			_, _ = buffer.WriteString("HTTP/1.1 101 Switching Protocols\r\n" +
+				"Upgrade: websocket\r\n" +
+				"Connection: Upgrade\r\n" +
+				"Sec-WebSocket-Accept: " +
+				base64.StdEncoding.EncodeToString(accept[:]) + "\r\n\r\n",
+			)
+			_ = buffer.Flush()
+
+			serve(atomic.AddInt32(&count, 1), conn, buffer.Reader)
+		},
+	))
+}
+
+func __waitWebSocketTest(t *testing.T, channel chan struct{}, name string) {
+	select {
+	case <-channel:
+	case <-time.After(websocket_TEST_TIMEOUT):
+		t.Fatalf("%s was not called", name)
+	}
+}
+
+func __webSocketTestURL(server *httptest.Server) string {
+	return "ws" + strings.TrimPrefix(server.URL, "http")
+}
+
+func __writeWebSocketTest(conn net.Conn, header byte, payload []byte) {
+	var frame []byte
+
+	// server frames are never masked
+	frame = []byte{header}
+	switch {
+	case len(payload) < 126:
+		frame = append(frame, byte(len(payload)))
+	default:
+		frame = append(frame, 126, 0, 0)
+		binary.BigEndian.PutUint16(frame[2:], uint16(len(payload)))
+	}
+
+	_, _ = conn.Write(append(frame, payload...))
+}
@@ -0,0 +1,279 @@
+// Copyright 2022 "Holloway" Chew, Kean Ho <hollowaykeanho@gmail.com>
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+//go:build wasm
+// +build wasm
+
+package hestiaWASM
+
+import (
+	"hestiaGo/hestiaError"
+	"sync"
+	"syscall/js"
+)
+
+const (
+	websocket_BINARY_TYPE = "arraybuffer"
+	websocket_STATE_OPEN  = 1
+)
+
+const (
+	websocket_EVENT_OPEN    = 1
+	websocket_EVENT_MESSAGE = 2
+	websocket_EVENT_CLOSE   = 3
+	websocket_EVENT_ERROR   = 4
+)
+
+type webSocketConn struct {
+	socket   js.Value
+	handlers []js.Func
+	names    []string
+	events   []*webSocketEvent
+	notify   chan struct{}
+	mutex    sync.Mutex
+}
+
+type webSocketEvent struct {
+	message *WebSocketMessage
+	reason  string
+	code    uint16
+	kind    uint8
+}
+
+func _closeWebSocket(conn *webSocketConn, code uint16, reason string) {
+	defer func() {
+		// invalid close code; fallback to normal closure
+		if r := recover(); r != nil {
+			conn.socket.Call(id_JS_WEBSOCKET_CLOSE, websocket_CLOSE_NORMAL)
+		}
+	}()
+
+	conn.socket.Call(id_JS_WEBSOCKET_CLOSE, code, reason)
+}
+
+func _dialWebSocket(ws *WebSocket) (conn *webSocketConn, err hestiaError.Error) {
+	var constructor, socket js.Value
+	var protocols []any
+	var event *webSocketEvent
+	var i int
+
+	constructor = Global().value.Get(id_JS_WEBSOCKET)
+	if constructor.Type() != js.TypeFunction {
+		return nil, hestiaError.EOPNOTSUPP
+	}
+
+	protocols = make([]any, len(ws.Protocols))
+	for i = range ws.Protocols {
+		protocols[i] = ws.Protocols[i]
+	}
+
+	socket, err = __newWebSocket(constructor, ws.URL, protocols)
+	if err != hestiaError.OK {
+		return nil, err
+	}
+	socket.Set(id_JS_WEBSOCKET_BINARY_TYPE, websocket_BINARY_TYPE)
+
+	conn = &webSocketConn{
+		socket: socket,
+		notify: make(chan struct{}, 1),
+	}
+	__listenWebSocket(conn)
+
+	// wait for the connection verdict. A failed connection fires `error`
+	// then `close` so the handlers are only released after `close`.
+	event = __waitWebSocket(conn)
+	for event.kind == websocket_EVENT_ERROR {
+		event = __waitWebSocket(conn)
+	}
+
+	if event.kind != websocket_EVENT_OPEN {
+		__releaseWebSocket(conn)
+		return nil, hestiaError.ECONNREFUSED
+	}
+
+	return conn, hestiaError.OK
+}
+
+func _receiveWebSocket(conn *webSocketConn) (*WebSocketMessage, uint16, string) {
+	var event *webSocketEvent
+
+	for {
+		event = __waitWebSocket(conn)
+		switch event.kind {
+		case websocket_EVENT_MESSAGE:
+			return event.message, 0, ""
+		case websocket_EVENT_CLOSE:
+			__releaseWebSocket(conn)
+			return nil, event.code, event.reason
+		}
+
+		// error event is always followed by a close event
+	}
+}
+
+func _sendWebSocket(conn *webSocketConn, message *WebSocketMessage) (err hestiaError.Error) {
+	var array *Object
+
+	if conn.socket.Get(id_JS_WEBSOCKET_READY_STATE).Int() != websocket_STATE_OPEN {
+		return hestiaError.ENOTCONN
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			err = hestiaError.ENOTCONN
+		}
+	}()
+
+	if !message.IsBinary {
+		conn.socket.Call(id_JS_WEBSOCKET_SEND, string(message.Data))
+		return hestiaError.OK
+	}
+
+	array, err = NewUint8Array(message.Data)
+	if err != hestiaError.OK {
+		return hestiaError.EINVAL
+	}
+
+	conn.socket.Call(id_JS_WEBSOCKET_SEND, *(array.value))
+
+	return hestiaError.OK
+}
+
+// NOTE: all functions below are sub-functions. Please use the global version
+// since it has proper guarding like `nil` object checking.
+
+func __listenWebSocket(conn *webSocketConn) {
+	var listen func(name string, fx func(js.Value) *webSocketEvent)
+
+	listen = func(name string, fx func(js.Value) *webSocketEvent) {
+		handler := __funcOf(FUNC_KIND_WEBSOCKET, func(this js.Value, args []js.Value) any {
+			__pushWebSocket(conn, fx(args[0]))
+			return nil
+		})
+
+		conn.socket.Call(id_JS_ADD_EVENT_LISTENER, name, handler)
+		conn.handlers = append(conn.handlers, handler)
+		conn.names = append(conn.names, name)
+	}
+
+	listen(id_JS_WEBSOCKET_OPEN, func(e js.Value) *webSocketEvent {
+		return &webSocketEvent{kind: websocket_EVENT_OPEN}
+	})
+
+	listen(id_JS_WEBSOCKET_MESSAGE, func(e js.Value) *webSocketEvent {
+		return &webSocketEvent{
+			kind:    websocket_EVENT_MESSAGE,
+			message: __newWebSocketMessage(e.Get(id_JS_WEBSOCKET_DATA)),
+		}
+	})
+
+	listen(id_JS_WEBSOCKET_ERROR, func(e js.Value) *webSocketEvent {
+		return &webSocketEvent{kind: websocket_EVENT_ERROR}
+	})
+
+	listen(id_JS_WEBSOCKET_CLOSE, func(e js.Value) *webSocketEvent {
+		return &webSocketEvent{
+			kind:   websocket_EVENT_CLOSE,
+			code:   uint16(e.Get(id_JS_WEBSOCKET_CODE).Int()),
+			reason: e.Get(id_JS_WEBSOCKET_REASON).String(),
+		}
+	})
+}
+
+func __newWebSocket(constructor js.Value,
+	url string,
+	protocols []any) (socket js.Value, err hestiaError.Error) {
+	defer func() {
+		// malformed URL or protocols
+		if r := recover(); r != nil {
+			socket = js.Undefined()
+			err = hestiaError.EINVAL
+		}
+	}()
+
+	return constructor.New(url, protocols), hestiaError.OK
+}
+
+func __newWebSocketMessage(data js.Value) *WebSocketMessage {
+	var array js.Value
+	var out []byte
+	var ok bool
+
+	if data.Type() == js.TypeString {
+		return &WebSocketMessage{
+			Data: []byte(data.String()),
+		}
+	}
+
+	array, ok = __toUint8Array(data)
+	if ok {
+		out = make([]byte, array.Get(id_JS_LENGTH).Int())
+		js.CopyBytesToGo(out, array)
+	}
+
+	return &WebSocketMessage{
+		Data:     out,
+		IsBinary: true,
+	}
+}
+
+func __pushWebSocket(conn *webSocketConn, event *webSocketEvent) {
+	conn.mutex.Lock()
+	conn.events = append(conn.events, event)
+	conn.mutex.Unlock()
+
+	// never block the Javascript callback
+	select {
+	case conn.notify <- struct{}{}:
+	default:
+	}
+}
+
+func __releaseWebSocket(conn *webSocketConn) {
+	var i int
+
+	for i = range conn.handlers {
+		conn.socket.Call(id_JS_REMOVE_EVENT_LISTENER,
+			conn.names[i],
+			conn.handlers[i],
+		)
+
+		__release(FUNC_KIND_WEBSOCKET, &conn.handlers[i])
+	}
+	conn.handlers = nil
+	conn.names = nil
+}
+
+func __waitWebSocket(conn *webSocketConn) (event *webSocketEvent) {
+	for {
+		conn.mutex.Lock()
+		if len(conn.events) > 0 {
+			event = conn.events[0]
+			conn.events[0] = nil
+			conn.events = conn.events[1:]
+			conn.mutex.Unlock()
+
+			return event
+		}
+		conn.mutex.Unlock()
+
+		<-conn.notify
+	}
+}